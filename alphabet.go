@@ -4,6 +4,10 @@ package base58
 type Alphabet struct {
 	decode [128]int8
 	encode [58]byte
+
+	// digits maps characters to their value plus one, leaving 0 for
+	// invalid characters, which is the form the SIMD kernels look up.
+	digits [128]byte
 }
 
 // NewAlphabet creates a new alphabet from the passed string.
@@ -26,6 +30,7 @@ func NewAlphabet(s string) *Alphabet {
 			distinct++
 		}
 		ret.decode[b] = int8(i)
+		ret.digits[b] = byte(i) + 1
 	}
 
 	if distinct != 58 {
@@ -40,3 +45,19 @@ var BTCAlphabet = NewAlphabet("123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopq
 
 // FlickrAlphabet is the flickr base58 alphabet.
 var FlickrAlphabet = NewAlphabet("123456789abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ")
+
+// RippleAlphabet is the ripple base58 alphabet.
+var RippleAlphabet = NewAlphabet("rpshnaf39wBUDNEGHJKLM4PQRST7VWXYZ2bcdeCg65jkm8oFqi1tuvAxyz")
+
+// IsOrderPreserving reports whether the characters of the alphabet are in
+// ascending byte order, so that encodings of equal width sort like the
+// numbers they encode. BTCAlphabet is order preserving, FlickrAlphabet is
+// not.
+func (a *Alphabet) IsOrderPreserving() bool {
+	for i := 1; i < len(a.encode); i++ {
+		if a.encode[i-1] >= a.encode[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,313 @@
+package base58
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"hash"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+)
+
+// ArmorBlock is a decoded armored block.
+type ArmorBlock struct {
+	Label   string
+	Headers map[string]string
+	Data    []byte
+}
+
+var (
+	// ErrArmorFormat is returned for malformed armor.
+	ErrArmorFormat = errors.New("invalid base58 armor")
+
+	// ErrArmorLabel is returned when the END line does not repeat the label
+	// of the BEGIN line.
+	ErrArmorLabel = errors.New("mismatched base58 armor label")
+
+	// ErrArmorHeader is returned when asked to write a label or header that
+	// cannot be represented in armor.
+	ErrArmorHeader = errors.New("invalid base58 armor label or header")
+)
+
+// Armor returns the armored form of b, a PEM-like text block that survives
+// being pasted into emails and tickets:
+//
+//	-----BEGIN LABEL-----
+//	Key: value
+//
+//	<data in the line-framed format of NewEncoder>
+//	=<base58 of the first 4 bytes of the double SHA-256 of data>
+//	-----END LABEL-----
+//
+// Headers are optional and written in sorted order; the blank line that ends
+// them is not.
+func Armor(b *ArmorBlock) (string, error) {
+	return ArmorAlphabet(b, BTCAlphabet)
+}
+
+// ArmorAlphabet is like Armor but uses the passed alphabet, which must not
+// contain '=' or '\n'.
+func ArmorAlphabet(b *ArmorBlock, alphabet *Alphabet) (string, error) {
+	var buf bytes.Buffer
+	w, err := NewArmorWriterAlphabet(&buf, b.Label, b.Headers, alphabet)
+	if err != nil {
+		return "", err
+	}
+	w.Write(b.Data)
+	w.Close()
+	return buf.String(), nil
+}
+
+// Dearmor decodes the first armored block in s. It skips any text before
+// the BEGIN line and accepts CRLF line endings and trailing spaces.
+func Dearmor(s string) (*ArmorBlock, error) {
+	return DearmorAlphabet(s, BTCAlphabet)
+}
+
+// DearmorAlphabet is like Dearmor but uses the passed alphabet.
+func DearmorAlphabet(s string, alphabet *Alphabet) (*ArmorBlock, error) {
+	r, err := NewArmorReaderAlphabet(strings.NewReader(s), alphabet)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return &ArmorBlock{Label: r.Label, Headers: r.Headers, Data: data}, nil
+}
+
+// NewArmorWriter writes the BEGIN line and headers to w and returns a writer
+// that armors the data written to it. The caller must Close it to write the
+// checksum and END lines. Closing does not close w.
+func NewArmorWriter(w io.Writer, label string, headers map[string]string) (io.WriteCloser, error) {
+	return NewArmorWriterAlphabet(w, label, headers, BTCAlphabet)
+}
+
+// NewArmorWriterAlphabet is like NewArmorWriter but uses the passed alphabet,
+// which must not contain '=' or '\n'.
+func NewArmorWriterAlphabet(w io.Writer, label string, headers map[string]string, alphabet *Alphabet) (io.WriteCloser, error) {
+	if !validArmorLabel(label) {
+		return nil, ErrArmorHeader
+	}
+	keys := make([]string, 0, len(headers))
+	for k, v := range headers {
+		if !validArmorHeader(k, v) {
+			return nil, ErrArmorHeader
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	buf.WriteString("-----BEGIN " + label + "-----\n")
+	for _, k := range keys {
+		buf.WriteString(k + ": " + headers[k] + "\n")
+	}
+	buf.WriteString("\n")
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		return nil, err
+	}
+
+	return &armorWriter{
+		w:        w,
+		label:    label,
+		alphabet: alphabet,
+		enc:      NewEncoderAlphabet(w, alphabet),
+		hash:     sha256.New(),
+	}, nil
+}
+
+type armorWriter struct {
+	w        io.Writer
+	label    string
+	alphabet *Alphabet
+	enc      io.WriteCloser
+	hash     hash.Hash
+}
+
+func (a *armorWriter) Write(p []byte) (int, error) {
+	n, err := a.enc.Write(p)
+	a.hash.Write(p[:n])
+	return n, err
+}
+
+// Close flushes the data and writes the checksum and END lines.
+func (a *armorWriter) Close() error {
+	if err := a.enc.Close(); err != nil {
+		return err
+	}
+	sum := sha256.Sum256(a.hash.Sum(nil))
+	trailer := "=" + EncodeAlphabet(sum[:checksumSize], a.alphabet) + "\n" +
+		"-----END " + a.label + "-----\n"
+	_, err := io.WriteString(a.w, trailer)
+	return err
+}
+
+// ArmorReader decodes an armored block. Data is returned as it is decoded and
+// is only known to be intact once Read has returned io.EOF; a checksum
+// mismatch is reported as ErrChecksum in place of io.EOF.
+type ArmorReader struct {
+	Label   string
+	Headers map[string]string
+
+	r        *bufio.Reader
+	alphabet *Alphabet
+	body     *streamDecoder
+	hash     hash.Hash
+	sum      []byte
+	err      error
+}
+
+// NewArmorReader reads up to and including the headers of the first armored
+// block in r.
+func NewArmorReader(r io.Reader) (*ArmorReader, error) {
+	return NewArmorReaderAlphabet(r, BTCAlphabet)
+}
+
+// NewArmorReaderAlphabet is like NewArmorReader but uses the passed alphabet.
+func NewArmorReaderAlphabet(r io.Reader, alphabet *Alphabet) (*ArmorReader, error) {
+	a := &ArmorReader{
+		Headers:  make(map[string]string),
+		r:        bufio.NewReader(r),
+		alphabet: alphabet,
+		hash:     sha256.New(),
+	}
+
+	for {
+		line, err := a.line()
+		if err != nil {
+			return nil, err
+		}
+		if label, ok := armorLine(line, "BEGIN"); ok {
+			a.Label = label
+			break
+		}
+	}
+
+	for {
+		line, err := a.line()
+		if err != nil {
+			return nil, err
+		}
+		if line == "" {
+			break
+		}
+		i := strings.IndexByte(line, ':')
+		if i < 0 {
+			return nil, ErrArmorFormat
+		}
+		k, v := line[:i], strings.TrimSpace(line[i+1:])
+		if _, dup := a.Headers[k]; dup || !validArmorHeader(k, v) {
+			return nil, ErrArmorFormat
+		}
+		a.Headers[k] = v
+	}
+
+	a.body = &streamDecoder{codec: NewCodec(alphabet)}
+	a.body.lines = a.bodyLine
+	return a, nil
+}
+
+func (a *ArmorReader) Read(p []byte) (int, error) {
+	if a.err != nil {
+		return 0, a.err
+	}
+	n, err := a.body.Read(p)
+	a.hash.Write(p[:n])
+	if err == io.EOF {
+		sum := sha256.Sum256(a.hash.Sum(nil))
+		if !bytes.Equal(sum[:checksumSize], a.sum) {
+			err = ErrChecksum
+		}
+	}
+	a.err = err
+	return n, err
+}
+
+// bodyLine returns the next line of encoded data, or io.EOF once it has read
+// the checksum and END lines.
+func (a *ArmorReader) bodyLine() ([]byte, error) {
+	line, err := a.line()
+	if err != nil {
+		return nil, err
+	}
+	if line == "" || len(line) > maxStreamLine {
+		return nil, ErrArmorFormat
+	}
+	if line[0] != '=' {
+		return []byte(line), nil
+	}
+
+	sum, err := DecodeAlphabet(line[1:], a.alphabet)
+	if err != nil || len(sum) != checksumSize {
+		return nil, ErrArmorFormat
+	}
+	end, err := a.line()
+	if err != nil {
+		return nil, err
+	}
+	label, ok := armorLine(end, "END")
+	if !ok {
+		return nil, ErrArmorFormat
+	}
+	if label != a.Label {
+		return nil, ErrArmorLabel
+	}
+	a.sum = sum
+	return nil, io.EOF
+}
+
+// line returns the next line without its line ending or trailing spaces. An
+// armored block never ends without its END line, so io.EOF is reported as
+// ErrArmorFormat.
+func (a *ArmorReader) line() (string, error) {
+	line, err := a.r.ReadString('\n')
+	if err == io.EOF && line != "" {
+		err = nil
+	}
+	if err == io.EOF {
+		return "", ErrArmorFormat
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, " \t\r\n"), nil
+}
+
+// armorLine reports whether line is a BEGIN or END line, as given by kind,
+// and returns its label.
+func armorLine(line, kind string) (string, bool) {
+	prefix := "-----" + kind + " "
+	if !strings.HasPrefix(line, prefix) || !strings.HasSuffix(line, "-----") ||
+		len(line) < len(prefix)+5 {
+		return "", false
+	}
+	label := line[len(prefix) : len(line)-5]
+	return label, validArmorLabel(label)
+}
+
+// validArmorLabel reports whether s is printable ASCII without dashes or
+// surrounding spaces.
+func validArmorLabel(s string) bool {
+	return armorText(s) && s != "" && strings.TrimSpace(s) == s && !strings.Contains(s, "-")
+}
+
+// validArmorHeader reports whether k and v survive a write and read of the
+// header line "k: v".
+func validArmorHeader(k, v string) bool {
+	return armorText(k) && armorText(v) && k != "" && strings.TrimSpace(k) == k &&
+		!strings.Contains(k, ":") && strings.TrimSpace(v) == v
+}
+
+func armorText(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 0x20 || s[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
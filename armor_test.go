@@ -0,0 +1,116 @@
+package base58
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestArmorRoundTrip(t *testing.T) {
+	for _, size := range []int{0, 1, 32, StreamBlockSize, 1000} {
+		data := make([]byte, size)
+		rand.Read(data)
+		in := &ArmorBlock{
+			Label:   "BASE58 PRIVATE KEY",
+			Headers: map[string]string{"Network": "mainnet", "Comment": "test: key"},
+			Data:    data,
+		}
+		s, err := Armor(in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, err := Dearmor("Some text before the block.\r\n" + s + "trailing text\n")
+		if err != nil {
+			t.Fatalf("size %d: %v\n%s", size, err, s)
+		}
+		if out.Label != in.Label || len(out.Headers) != 2 ||
+			out.Headers["Comment"] != "test: key" || !bytes.Equal(out.Data, data) {
+			t.Errorf("size %d: got %+v", size, out)
+		}
+	}
+}
+
+func TestArmorFormat(t *testing.T) {
+	s, err := Armor(&ArmorBlock{
+		Label:   "MESSAGE",
+		Headers: map[string]string{"B": "2", "A": "1"},
+		Data:    []byte("Hello World!"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := checksum([]byte("Hello World!"))
+	want := "-----BEGIN MESSAGE-----\nA: 1\nB: 2\n\n2NEpo7TZRRrLZSi2U\n=" +
+		Encode(sum[:]) + "\n-----END MESSAGE-----\n"
+	if s != want {
+		t.Errorf("got\n%s\nwant\n%s", s, want)
+	}
+
+	// CRLF line endings and trailing spaces are tolerated.
+	crlf := strings.Replace(s, "\n", " \r\n", -1)
+	if b, err := Dearmor(crlf); err != nil || string(b.Data) != "Hello World!" {
+		t.Errorf("CRLF: got %v, %v", b, err)
+	}
+}
+
+func TestArmorErrors(t *testing.T) {
+	good, _ := Armor(&ArmorBlock{Label: "KEY", Data: []byte("Hello World!")})
+	tests := []struct {
+		in   string
+		want error
+	}{
+		{strings.Replace(good, "END KEY", "END OTHER", 1), ErrArmorLabel},
+		{strings.Replace(good, "2NEpo7TZRRrLZSi2U", "2NEpo7TZRRrLZSi2V", 1), ErrChecksum},
+		{strings.Replace(good, "-----END KEY-----\n", "", 1), ErrArmorFormat},
+		{strings.Replace(good, "\n\n", "\n", 1), ErrArmorFormat},
+		{strings.Replace(good, "\n=", "\n\n=", 1), ErrArmorFormat},
+		{"no armor here\n", ErrArmorFormat},
+		{"-----BEGIN KEY-----\nbad header\n\n", ErrArmorFormat},
+	}
+	for _, tt := range tests {
+		if _, err := Dearmor(tt.in); err != tt.want {
+			t.Errorf("%q: got %v, want %v", tt.in, err, tt.want)
+		}
+	}
+
+	for _, b := range []*ArmorBlock{
+		{Label: ""},
+		{Label: "-KEY"},
+		{Label: "KEY", Headers: map[string]string{"a:b": "c"}},
+		{Label: "KEY", Headers: map[string]string{"a": "b\nc"}},
+	} {
+		if _, err := Armor(b); err != ErrArmorHeader {
+			t.Errorf("%+v: got %v", b, err)
+		}
+	}
+}
+
+func TestArmorStream(t *testing.T) {
+	data := make([]byte, 100000)
+	rand.Read(data)
+
+	var buf bytes.Buffer
+	w, err := NewArmorWriterAlphabet(&buf, "DATA", nil, FlickrAlphabet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	io.Copy(w, bytes.NewReader(data))
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := NewArmorReaderAlphabet(&buf, FlickrAlphabet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Label != "DATA" || len(r.Headers) != 0 {
+		t.Errorf("got label %q, headers %v", r.Label, r.Headers)
+	}
+	got, err := ioutil.ReadAll(r)
+	if err != nil || !bytes.Equal(got, data) {
+		t.Errorf("stream did not round trip: %v", err)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 )
 
+//go:generate go run gen_unrolled.go -lengths 25,32,33,34,64,82 -alphabets BTCAlphabet,FlickrAlphabet
+
 // Encode encodes the passed bytes into a base58 encoded string.
 func Encode(bin []byte) string {
 	return FastBase58EncodingAlphabet(bin, BTCAlphabet)
@@ -23,7 +25,18 @@ func FastBase58Encoding(bin []byte) string {
 // FastBase58EncodingAlphabet encodes the passed bytes into a base58 encoded
 // string with the passed alphabet.
 func FastBase58EncodingAlphabet(bin []byte, alphabet *Alphabet) string {
+	if s, ok := encodeUnrolled(bin, alphabet); ok {
+		return s
+	}
+	if len(bin) > largeEncodeThreshold {
+		return largeEncode(bin, alphabet)
+	}
+	return fastEncode(bin, alphabet)
+}
 
+// fastEncode is the quadratic encoding algorithm, which is the fastest for
+// short inputs.
+func fastEncode(bin []byte, alphabet *Alphabet) string {
 	size := len(bin)
 
 	zcount := 0
@@ -38,7 +51,18 @@ func FastBase58EncodingAlphabet(bin []byte, alphabet *Alphabet) string {
 		// ceil(log(256)/log(58))
 		(size-zcount)*555/406 + 1
 
-	out := make([]byte, size)
+	return string(fastEncodeTo(make([]byte, size), bin, alphabet))
+}
+
+// fastEncodeTo encodes bin into out, which must be zeroed and at least as long
+// as fastEncode's size estimate, and returns the encoded prefix of out.
+func fastEncodeTo(out, bin []byte, alphabet *Alphabet) []byte {
+	size := len(out)
+
+	zcount := 0
+	for zcount < len(bin) && bin[zcount] == 0 {
+		zcount++
+	}
 
 	var i, high int
 	var carry uint32
@@ -65,7 +89,7 @@ func FastBase58EncodingAlphabet(bin []byte, alphabet *Alphabet) string {
 		out[i] = alphabet.encode[val[i]]
 	}
 
-	return string(out[:size])
+	return out[:size]
 }
 
 // Decode decodes the base58 encoded bytes.
@@ -86,6 +110,29 @@ func FastBase58Decoding(str string) ([]byte, error) {
 // FastBase58DecodingAlphabet decodes the base58 encoded bytes using the given
 // b58 alphabet.
 func FastBase58DecodingAlphabet(str string, alphabet *Alphabet) ([]byte, error) {
+	if unrolledDecodable(len(str), alphabet) {
+		return decodeUnrolled(str, alphabet)
+	}
+	if len(str) > largeDecodeThreshold {
+		return largeDecode(str, alphabet)
+	}
+	return fastDecode(str, alphabet)
+}
+
+// fastDecode is the quadratic decoding algorithm, which is the fastest for
+// short inputs.
+func fastDecode(str string, alphabet *Alphabet) ([]byte, error) {
+	// the 32bit algo stretches the result up to 2 times
+	binu := make([]byte, 2*((len(str)*406/555)+1))
+	outi := make([]uint32, (len(str)+3)/4)
+
+	return fastDecodeTo(binu, outi, str, alphabet)
+}
+
+// fastDecodeTo decodes str using the caller's scratch space: binu must be at
+// least 2*((len(str)*406/555)+1) bytes long and outi must hold exactly
+// (len(str)+3)/4 zeroed limbs. The result is a slice of binu.
+func fastDecodeTo(binu []byte, outi []uint32, str string, alphabet *Alphabet) ([]byte, error) {
 	if len(str) == 0 {
 		return nil, fmt.Errorf("zero length string")
 	}
@@ -98,27 +145,25 @@ func FastBase58DecodingAlphabet(str string, alphabet *Alphabet) ([]byte, error)
 		zcount++
 	}
 
-	var t, c uint64
-
-	// the 32bit algo stretches the result up to 2 times
-	binu := make([]byte, 2*((b58sz*406/555)+1))
-	outi := make([]uint32, (b58sz+3)/4)
+	// Translate the characters to digit values, using the front of binu,
+	// which is longer than str, as scratch space.
+	digits := binu[:b58sz]
+	if n := translate(digits, str, alphabet); n < b58sz {
+		return nil, invalidDigitError(str, n)
+	}
 
-	for _, r := range str {
-		if r > 127 {
-			return nil, fmt.Errorf("high-bit set on invalid digit")
+	// Fold in five digits per pass over the limbs; 58^5 still fits in 32 bits.
+	for i := 0; i < b58sz; i += 5 {
+		end := i + 5
+		if end > b58sz {
+			end = b58sz
 		}
-		if alphabet.decode[r] == -1 {
-			return nil, fmt.Errorf("invalid base58 digit (%q)", r)
-		}
-
-		c = uint64(alphabet.decode[r])
-
-		for j := len(outi) - 1; j >= 0; j-- {
-			t = uint64(outi[j])*58 + c
-			c = t >> 32
-			outi[j] = uint32(t & 0xffffffff)
+		m, c := uint32(1), uint32(0)
+		for _, d := range digits[i:end] {
+			m *= 58
+			c = c*58 + uint32(d)
 		}
+		mulAdd(outi, m, c)
 	}
 
 	// initial mask depends on b58sz, on further loops it always starts at 24 bits
@@ -139,7 +184,7 @@ func FastBase58DecodingAlphabet(str string, alphabet *Alphabet) ([]byte, error)
 	}
 
 	// find the most significant byte post-decode, if any
-	for msb := zcount; msb < len(binu); msb++ {
+	for msb := zcount; msb < outLen; msb++ {
 		if binu[msb] > 0 {
 			return binu[msb-zcount : outLen], nil
 		}
@@ -148,3 +193,12 @@ func FastBase58DecodingAlphabet(str string, alphabet *Alphabet) ([]byte, error)
 	// it's all zeroes
 	return binu[:outLen], nil
 }
+
+// invalidDigitError returns the error for the invalid character at index i of
+// str.
+func invalidDigitError(str string, i int) error {
+	if str[i] > 127 {
+		return fmt.Errorf("high-bit set on invalid digit")
+	}
+	return fmt.Errorf("invalid base58 digit (%q)", rune(str[i]))
+}
@@ -133,7 +133,7 @@ func BenchmarkTrivialBase58Encoding(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		TrivialBase58Encoding([]byte(testPairs[i].dec))
+		TrivialBase58Encoding([]byte(testPairs[i%len(testPairs)].dec))
 	}
 }
 
@@ -142,7 +142,7 @@ func BenchmarkFastBase58Encoding(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		FastBase58Encoding(testPairs[i].dec)
+		FastBase58Encoding(testPairs[i%len(testPairs)].dec)
 	}
 }
 
@@ -151,7 +151,7 @@ func BenchmarkTrivialBase58Decoding(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		TrivialBase58Decoding(testPairs[i].enc)
+		TrivialBase58Decoding(testPairs[i%len(testPairs)].enc)
 	}
 }
 
@@ -160,6 +160,6 @@ func BenchmarkFastBase58Decoding(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		FastBase58Decoding(testPairs[i].enc)
+		FastBase58Decoding(testPairs[i%len(testPairs)].enc)
 	}
 }
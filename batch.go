@@ -0,0 +1,191 @@
+package base58
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// batchChunk is the minimum number of items given to each goroutine. Smaller
+// batches are processed on the calling goroutine.
+const batchChunk = 512
+
+// BatchError reports the failure of one item of a batch.
+type BatchError struct {
+	// Index is the position of the failed item in the batch.
+	Index int
+	// Err is the error returned for the item.
+	Err error
+}
+
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("item %d: %v", e.Index, e.Err)
+}
+
+// EncodeBatch encodes each of the passed byte slices into a base58 encoded
+// string.
+func EncodeBatch(bins [][]byte) []string {
+	return EncodeBatchAlphabet(bins, BTCAlphabet)
+}
+
+// EncodeBatchAlphabet encodes each of the passed byte slices into a base58
+// encoded string with the passed alphabet.
+//
+// All results share a single backing allocation, and large batches are
+// spread across GOMAXPROCS goroutines.
+func EncodeBatchAlphabet(bins [][]byte, alphabet *Alphabet) []string {
+	// offs[i] is the start of the region reserved for item i, sized for the
+	// longest possible encoding.
+	offs := make([]int, len(bins)+1)
+	for i, b := range bins {
+		offs[i+1] = offs[i] + len(b)*555/406 + 1
+	}
+	buf := make([]byte, offs[len(bins)])
+	ends := make([]int, len(bins))
+	chunks := batchChunks(len(bins))
+
+	// Each chunk packs its encodings at the start of its region and records
+	// where each one ends.
+	batchRun(chunks, func(lo, hi int) {
+		cur := offs[lo]
+		for i := lo; i < hi; i++ {
+			out := buf[cur:offs[i+1]]
+			var enc []byte
+			if len(bins[i]) > largeEncodeThreshold {
+				enc = out[:copy(out, largeEncode(bins[i], alphabet))]
+			} else {
+				for j := range out {
+					out[j] = 0
+				}
+				enc = fastEncodeTo(out, bins[i], alphabet)
+			}
+			cur += len(enc)
+			ends[i] = cur
+		}
+	})
+
+	// Close the gaps between chunks, then convert everything to a single
+	// string and slice it up.
+	total := 0
+	for _, c := range chunks {
+		lo, hi := c[0], c[1]
+		if lo == hi {
+			continue
+		}
+		n := copy(buf[total:], buf[offs[lo]:ends[hi-1]])
+		shift := offs[lo] - total
+		for i := lo; i < hi; i++ {
+			ends[i] -= shift
+		}
+		total += n
+	}
+	starts := make([]int, len(bins))
+	for i := range starts {
+		if i > 0 {
+			starts[i] = ends[i-1]
+		}
+	}
+
+	s := string(buf[:total])
+	out := make([]string, len(bins))
+	for i := range out {
+		out[i] = s[starts[i]:ends[i]]
+	}
+	return out
+}
+
+// DecodeBatch decodes each of the passed base58 encoded strings.
+func DecodeBatch(strs []string) ([][]byte, []error) {
+	return DecodeBatchAlphabet(strs, BTCAlphabet)
+}
+
+// DecodeBatchAlphabet decodes each of the passed base58 encoded strings using
+// the given b58 alphabet.
+//
+// All results share a single backing allocation, and large batches are
+// spread across GOMAXPROCS goroutines. Items that fail to decode are nil in
+// the result, and the returned errors hold a *BatchError for each of them in
+// index order. The errors are nil if every item decoded.
+func DecodeBatchAlphabet(strs []string, alphabet *Alphabet) ([][]byte, []error) {
+	// A base58 string never decodes to more bytes than it has characters.
+	offs := make([]int, len(strs)+1)
+	for i, s := range strs {
+		offs[i+1] = offs[i] + len(s)
+	}
+	buf := make([]byte, offs[len(strs)])
+	out := make([][]byte, len(strs))
+	failed := make([]error, len(strs))
+
+	batchRun(batchChunks(len(strs)), func(lo, hi int) {
+		longest := 0
+		for _, s := range strs[lo:hi] {
+			if len(s) > longest && len(s) <= largeDecodeThreshold {
+				longest = len(s)
+			}
+		}
+		binu := make([]byte, 2*((longest*406/555)+1))
+		outi := make([]uint32, (longest+3)/4)
+
+		for i := lo; i < hi; i++ {
+			var dec []byte
+			var err error
+			if s := strs[i]; len(s) > largeDecodeThreshold {
+				dec, err = largeDecode(s, alphabet)
+			} else {
+				limbs := outi[:(len(s)+3)/4]
+				for j := range limbs {
+					limbs[j] = 0
+				}
+				dec, err = fastDecodeTo(binu, limbs, s, alphabet)
+			}
+			if err != nil {
+				failed[i] = &BatchError{Index: i, Err: err}
+				continue
+			}
+			n := copy(buf[offs[i]:], dec)
+			out[i] = buf[offs[i] : offs[i]+n : offs[i]+n]
+		}
+	})
+
+	var errs []error
+	for _, err := range failed {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return out, errs
+}
+
+// batchChunks splits n items into consecutive [lo, hi) ranges, one per
+// worker.
+func batchChunks(n int) [][2]int {
+	workers := runtime.GOMAXPROCS(0)
+	if limit := n / batchChunk; workers > limit {
+		workers = limit
+	}
+	if workers < 1 {
+		workers = 1
+	}
+	chunks := make([][2]int, workers)
+	for w := range chunks {
+		chunks[w] = [2]int{n * w / workers, n * (w + 1) / workers}
+	}
+	return chunks
+}
+
+// batchRun calls fn on each chunk, concurrently if there is more than one.
+func batchRun(chunks [][2]int, fn func(lo, hi int)) {
+	if len(chunks) == 1 {
+		fn(chunks[0][0], chunks[0][1])
+		return
+	}
+	var wg sync.WaitGroup
+	wg.Add(len(chunks))
+	for _, c := range chunks {
+		go func(lo, hi int) {
+			defer wg.Done()
+			fn(lo, hi)
+		}(c[0], c[1])
+	}
+	wg.Wait()
+}
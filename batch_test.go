@@ -0,0 +1,98 @@
+package base58
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+)
+
+func randomBatch(n int) [][]byte {
+	bins := make([][]byte, n)
+	for i := range bins {
+		b := make([]byte, rand.Intn(300))
+		rand.Read(b)
+		for j := 0; j < len(b) && j < i%3; j++ {
+			b[j] = 0
+		}
+		bins[i] = b
+	}
+	return bins
+}
+
+func TestEncodeDecodeBatch(t *testing.T) {
+	// Sizes below and above the parallel chunk size.
+	for _, n := range []int{0, 1, 10, 4*batchChunk + 7} {
+		bins := randomBatch(n)
+		for _, alph := range []*Alphabet{BTCAlphabet, FlickrAlphabet} {
+			encs := EncodeBatchAlphabet(bins, alph)
+			if len(encs) != n {
+				t.Fatalf("got %d encodings for %d items", len(encs), n)
+			}
+			for i, b := range bins {
+				if want := EncodeAlphabet(b, alph); encs[i] != want {
+					t.Fatalf("item %d: batch encoding differs", i)
+				}
+			}
+
+			decs, errs := DecodeBatchAlphabet(encs, alph)
+			for _, err := range errs {
+				if be := err.(*BatchError); len(bins[be.Index]) != 0 {
+					t.Fatalf("unexpected error %v", err)
+				}
+			}
+			for i, b := range bins {
+				if !bytes.Equal(decs[i], b) {
+					t.Fatalf("item %d: batch decoding differs", i)
+				}
+			}
+		}
+	}
+}
+
+func TestDecodeBatchErrors(t *testing.T) {
+	decs, errs := DecodeBatch([]string{"2NEpo7TZRRrLZSi2U", "0abc", "3mJr7AoUXx2Wqd", ""})
+	if len(errs) != 2 {
+		t.Fatalf("got %d errors, want 2", len(errs))
+	}
+	if be := errs[0].(*BatchError); be.Index != 1 {
+		t.Errorf("first error at index %d, want 1", be.Index)
+	}
+	if be := errs[1].(*BatchError); be.Index != 3 {
+		t.Errorf("second error at index %d, want 3", be.Index)
+	}
+	if string(decs[0]) != "Hello World!" || decs[1] != nil || decs[2] == nil {
+		t.Errorf("unexpected results %q", decs)
+	}
+
+	// Results share a buffer but must not overwrite each other on append.
+	_ = append(decs[0], 'x')
+	if decs[2][0] == 'x' {
+		t.Errorf("append to one result clobbered the next")
+	}
+}
+
+func BenchmarkEncodeBatch(b *testing.B) {
+	initTestPairs()
+	bins := make([][]byte, 10000)
+	for i := range bins {
+		bins[i] = testPairs[i].dec
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EncodeBatch(bins)
+	}
+}
+
+func BenchmarkDecodeBatch(b *testing.B) {
+	initTestPairs()
+	strs := make([]string, 10000)
+	for i := range strs {
+		strs[i] = testPairs[i].enc
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		DecodeBatch(strs)
+	}
+}
@@ -0,0 +1,245 @@
+package base58
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"sort"
+)
+
+// Byron address types.
+const (
+	ByronPubKey uint64 = 0
+	ByronScript uint64 = 1
+	ByronRedeem uint64 = 2
+)
+
+// Byron address attribute keys.
+const (
+	ByronAttrDerivationPath uint64 = 1
+	ByronAttrNetworkMagic   uint64 = 2
+)
+
+// ErrByronFormat is returned when a string does not decode to a well formed
+// Byron address envelope.
+var ErrByronFormat = errors.New("malformed byron address")
+
+const byronRootSize = 28
+
+// ByronAddress is a decoded Byron address. Byron-era Cardano addresses are
+// base58 encoded CBOR:
+//
+//	[ #6.24(bytes .cbor [ root, attributes, type ]), crc32 ]
+//
+// where crc32 is the IEEE CRC32 of the tagged payload bytes. Only the subset
+// of CBOR used by this envelope is supported.
+type ByronAddress struct {
+	// Root is the 28 byte hash identifying the address spending data.
+	Root []byte
+	// Attributes holds the raw CBOR encoded attribute values by key.
+	Attributes map[uint64][]byte
+	// Type is the address type, one of ByronPubKey, ByronScript or
+	// ByronRedeem.
+	Type uint64
+}
+
+// NetworkMagic returns the protocol magic stored in the address attributes.
+// Mainnet addresses carry no network magic.
+func (a *ByronAddress) NetworkMagic() (uint32, bool) {
+	v, ok := a.Attributes[ByronAttrNetworkMagic]
+	if !ok {
+		return 0, false
+	}
+	r := cborReader{buf: v}
+	magic, err := r.uint()
+	if err != nil || len(r.buf) != 0 || magic > 0xffffffff {
+		return 0, false
+	}
+	return uint32(magic), true
+}
+
+// ByronDecode decodes a Byron address and verifies its CRC32 checksum.
+func ByronDecode(addr string) (*ByronAddress, error) {
+	data, err := Decode(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	r := cborReader{buf: data}
+	if n, err := r.head(cborArray); err != nil || n != 2 {
+		return nil, ErrByronFormat
+	}
+	if tag, err := r.head(cborTag); err != nil || tag != 24 {
+		return nil, ErrByronFormat
+	}
+	payload, err := r.bytes()
+	if err != nil {
+		return nil, ErrByronFormat
+	}
+	crc, err := r.uint()
+	if err != nil || len(r.buf) != 0 {
+		return nil, ErrByronFormat
+	}
+	if uint64(crc32.ChecksumIEEE(payload)) != crc {
+		return nil, ErrChecksum
+	}
+
+	r = cborReader{buf: payload}
+	if n, err := r.head(cborArray); err != nil || n != 3 {
+		return nil, ErrByronFormat
+	}
+	root, err := r.bytes()
+	if err != nil || len(root) != byronRootSize {
+		return nil, ErrByronFormat
+	}
+	n, err := r.head(cborMap)
+	if err != nil || n > uint64(len(r.buf)) {
+		return nil, ErrByronFormat
+	}
+	attrs := make(map[uint64][]byte, n)
+	for i := uint64(0); i < n; i++ {
+		k, err := r.uint()
+		if err != nil {
+			return nil, ErrByronFormat
+		}
+		v, err := r.bytes()
+		if err != nil {
+			return nil, ErrByronFormat
+		}
+		if _, dup := attrs[k]; dup {
+			return nil, ErrByronFormat
+		}
+		attrs[k] = v
+	}
+	typ, err := r.uint()
+	if err != nil || len(r.buf) != 0 {
+		return nil, ErrByronFormat
+	}
+
+	return &ByronAddress{Root: root, Attributes: attrs, Type: typ}, nil
+}
+
+// ByronEncode encodes a Byron address. Attributes are written in canonical
+// (ascending key) order, so decoding and re-encoding an address produced by
+// a Cardano node yields the original string.
+func ByronEncode(a *ByronAddress) (string, error) {
+	if len(a.Root) != byronRootSize {
+		return "", ErrByronFormat
+	}
+
+	keys := make([]uint64, 0, len(a.Attributes))
+	for k := range a.Attributes {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	var payload []byte
+	payload = cborAppendHead(payload, cborArray, 3)
+	payload = cborAppendBytes(payload, a.Root)
+	payload = cborAppendHead(payload, cborMap, uint64(len(keys)))
+	for _, k := range keys {
+		payload = cborAppendHead(payload, cborUint, k)
+		payload = cborAppendBytes(payload, a.Attributes[k])
+	}
+	payload = cborAppendHead(payload, cborUint, a.Type)
+
+	var out []byte
+	out = cborAppendHead(out, cborArray, 2)
+	out = cborAppendHead(out, cborTag, 24)
+	out = cborAppendBytes(out, payload)
+	out = cborAppendHead(out, cborUint, uint64(crc32.ChecksumIEEE(payload)))
+
+	return Encode(out), nil
+}
+
+// CBOR major types used by the Byron envelope.
+const (
+	cborUint  byte = 0
+	cborBytes byte = 2
+	cborArray byte = 4
+	cborMap   byte = 5
+	cborTag   byte = 6
+)
+
+type cborReader struct {
+	buf []byte
+}
+
+// head reads a data item header of the expected major type and returns its
+// argument. Indefinite lengths are not supported.
+func (r *cborReader) head(major byte) (uint64, error) {
+	if len(r.buf) == 0 || r.buf[0]>>5 != major {
+		return 0, ErrByronFormat
+	}
+	info := r.buf[0] & 0x1f
+	r.buf = r.buf[1:]
+
+	var size int
+	switch {
+	case info < 24:
+		return uint64(info), nil
+	case info == 24:
+		size = 1
+	case info == 25:
+		size = 2
+	case info == 26:
+		size = 4
+	case info == 27:
+		size = 8
+	default:
+		return 0, ErrByronFormat
+	}
+	if len(r.buf) < size {
+		return 0, ErrByronFormat
+	}
+	var v uint64
+	for _, b := range r.buf[:size] {
+		v = v<<8 | uint64(b)
+	}
+	r.buf = r.buf[size:]
+	return v, nil
+}
+
+func (r *cborReader) uint() (uint64, error) {
+	return r.head(cborUint)
+}
+
+func (r *cborReader) bytes() ([]byte, error) {
+	n, err := r.head(cborBytes)
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(len(r.buf)) {
+		return nil, ErrByronFormat
+	}
+	b := r.buf[:n:n]
+	r.buf = r.buf[n:]
+	return b, nil
+}
+
+// cborAppendHead appends a data item header using the shortest encoding of
+// its argument, as canonical CBOR requires.
+func cborAppendHead(dst []byte, major byte, v uint64) []byte {
+	major <<= 5
+	switch {
+	case v < 24:
+		return append(dst, major|byte(v))
+	case v <= 0xff:
+		return append(dst, major|24, byte(v))
+	case v <= 0xffff:
+		dst = append(dst, major|25, 0, 0)
+		binary.BigEndian.PutUint16(dst[len(dst)-2:], uint16(v))
+	case v <= 0xffffffff:
+		dst = append(dst, major|26, 0, 0, 0, 0)
+		binary.BigEndian.PutUint32(dst[len(dst)-4:], uint32(v))
+	default:
+		dst = append(dst, major|27, 0, 0, 0, 0, 0, 0, 0, 0)
+		binary.BigEndian.PutUint64(dst[len(dst)-8:], v)
+	}
+	return dst
+}
+
+func cborAppendBytes(dst, b []byte) []byte {
+	dst = cborAppendHead(dst, cborBytes, uint64(len(b)))
+	return append(dst, b...)
+}
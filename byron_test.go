@@ -0,0 +1,78 @@
+package base58
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestByronKnown(t *testing.T) {
+	const addr = "Ae2tdPwUPEZFRbyhz3cpfC2CumGzNkFBN2L42rcUc2yjQpEkxDbkPodpMAi"
+
+	a, err := ByronDecode(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Type != ByronPubKey || len(a.Root) != 28 || len(a.Attributes) != 0 {
+		t.Errorf("unexpected address %+v", a)
+	}
+	if _, ok := a.NetworkMagic(); ok {
+		t.Errorf("mainnet address reports a network magic")
+	}
+
+	enc, err := ByronEncode(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enc != addr {
+		t.Errorf("ByronEncode = %s, want %s", enc, addr)
+	}
+}
+
+func TestByronRoundTrip(t *testing.T) {
+	a := &ByronAddress{
+		Root: bytes.Repeat([]byte{0x42}, 28),
+		Attributes: map[uint64][]byte{
+			ByronAttrNetworkMagic:   {0x1a, 0x2d, 0x96, 0x4a, 0x09}, // 764824073
+			ByronAttrDerivationPath: {0x43, 0x01, 0x02, 0x03},
+		},
+		Type: ByronScript,
+	}
+	enc, err := ByronEncode(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dec, err := ByronDecode(enc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(dec.Root, a.Root) || dec.Type != a.Type || len(dec.Attributes) != 2 {
+		t.Fatalf("round trip mismatch: %+v", dec)
+	}
+	for k, v := range a.Attributes {
+		if !bytes.Equal(dec.Attributes[k], v) {
+			t.Errorf("attribute %d = %x, want %x", k, dec.Attributes[k], v)
+		}
+	}
+	if magic, ok := dec.NetworkMagic(); !ok || magic != 764824073 {
+		t.Errorf("NetworkMagic() = %d, %v", magic, ok)
+	}
+}
+
+func TestByronErrors(t *testing.T) {
+	a := &ByronAddress{Root: make([]byte, 28)}
+	enc, _ := ByronEncode(a)
+	raw, _ := Decode(enc)
+
+	raw[len(raw)-1] ^= 1
+	if _, err := ByronDecode(Encode(raw)); err != ErrChecksum {
+		t.Errorf("corrupted crc: got %v", err)
+	}
+
+	if _, err := ByronDecode(Encode([]byte{0x82, 0x00})); err != ErrByronFormat {
+		t.Errorf("bad envelope: got %v", err)
+	}
+
+	if _, err := ByronEncode(&ByronAddress{Root: make([]byte, 20)}); err != ErrByronFormat {
+		t.Errorf("short root: got %v", err)
+	}
+}
@@ -0,0 +1,66 @@
+package base58
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+)
+
+// ErrCheckLength is returned when a Base58Check string decodes to fewer
+// bytes than the checksum itself.
+var ErrCheckLength = errors.New("input too short for checksum")
+
+const checksumSize = 4
+
+// CheckEncode encodes the payload followed by its checksum, the first four
+// bytes of its double SHA-256. This is the Base58Check encoding of Bitcoin
+// addresses, WIF keys and the many formats derived from them. Version bytes,
+// if any, are part of the payload.
+func CheckEncode(payload []byte) string {
+	return CheckEncodeAlphabet(payload, BTCAlphabet)
+}
+
+// CheckEncodeAlphabet encodes the payload followed by its checksum with the
+// passed alphabet.
+func CheckEncodeAlphabet(payload []byte, alphabet *Alphabet) string {
+	sum := checksum(payload)
+	buf := make([]byte, 0, len(payload)+checksumSize)
+	buf = append(buf, payload...)
+	buf = append(buf, sum[:]...)
+	return FastBase58EncodingAlphabet(buf, alphabet)
+}
+
+// CheckDecode decodes a Base58Check string, verifies the checksum and returns
+// the payload.
+func CheckDecode(str string) ([]byte, error) {
+	return CheckDecodeAlphabet(str, BTCAlphabet)
+}
+
+// CheckDecodeAlphabet decodes a Base58Check string using the given alphabet,
+// verifies the checksum and returns the payload.
+func CheckDecodeAlphabet(str string, alphabet *Alphabet) ([]byte, error) {
+	raw, err := FastBase58DecodingAlphabet(str, alphabet)
+	if err != nil {
+		return nil, err
+	}
+	return checkPayload(raw)
+}
+
+// checkPayload splits decoded Base58Check data and verifies its checksum.
+func checkPayload(raw []byte) ([]byte, error) {
+	if len(raw) < checksumSize {
+		return nil, ErrCheckLength
+	}
+	payload := raw[:len(raw)-checksumSize]
+	if sum := checksum(payload); !bytes.Equal(sum[:], raw[len(payload):]) {
+		return nil, ErrChecksum
+	}
+	return payload, nil
+}
+
+func checksum(b []byte) (sum [checksumSize]byte) {
+	h := sha256.Sum256(b)
+	h = sha256.Sum256(h[:])
+	copy(sum[:], h[:])
+	return sum
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mr-tron/base58"
+)
+
+// batchLines is the number of lines converted per library batch call.
+const batchLines = 4096
+
+// batchResult is one output record of the batch command.
+type batchResult struct {
+	Line   int    `json:"line"`
+	Output string `json:"output,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+func runBatch(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := newFlagSet("batch", stderr)
+	alph := newAlphabetFlag(fs)
+	outFormat := fs.String("format", "tsv", "output `format`: tsv (line, output, error) or jsonl")
+	binary := fs.String("bytes", "hex", "representation of the binary side: hex, or raw for encode input")
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+	if fs.NArg() != 1 || (fs.Arg(0) != "encode" && fs.Arg(0) != "decode") ||
+		(*outFormat != "tsv" && *outFormat != "jsonl") ||
+		(*binary != "hex" && *binary != "raw") {
+		fs.Usage()
+		return exitUsage
+	}
+	decode := fs.Arg(0) == "decode"
+	asHex := *binary == "hex"
+	if decode && !asHex {
+		// Raw bytes could hold tabs and newlines, and JSON would mangle
+		// invalid UTF-8.
+		fmt.Fprintln(stderr, "base58 batch: decoded bytes can only be written as hex")
+		return exitUsage
+	}
+
+	out := bufio.NewWriter(stdout)
+	defer out.Flush()
+	enc := json.NewEncoder(out)
+	write := func(r batchResult) {
+		if *outFormat == "jsonl" {
+			enc.Encode(r)
+			return
+		}
+		fmt.Fprintf(out, "%d\t%s\t%s\n", r.Line, r.Output, r.Error)
+	}
+
+	code := exitOK
+	sc := bufio.NewScanner(stdin)
+	sc.Buffer(nil, maxLine)
+	first := 1
+	lines := make([]string, 0, batchLines)
+	flush := func() {
+		results := make([]batchResult, len(lines))
+		for i := range results {
+			results[i].Line = first + i
+		}
+		if decode {
+			batchDecode(lines, results, alph.alphabet)
+		} else {
+			batchEncode(lines, results, alph.alphabet, asHex)
+		}
+		for _, r := range results {
+			if r.Error != "" {
+				code = exitFailure
+			}
+			write(r)
+		}
+		first += len(lines)
+		lines = lines[:0]
+	}
+	for sc.Scan() {
+		lines = append(lines, strings.TrimSuffix(sc.Text(), "\r"))
+		if len(lines) == batchLines {
+			flush()
+		}
+	}
+	flush()
+	if err := sc.Err(); err != nil {
+		out.Flush()
+		fmt.Fprintf(stderr, "base58 batch: %v\n", err)
+		return exitFailure
+	}
+	return code
+}
+
+// batchEncode encodes lines into results, reading each line as hex if asHex
+// is set and as raw bytes otherwise.
+func batchEncode(lines []string, results []batchResult, alphabet *base58.Alphabet, asHex bool) {
+	bins := make([][]byte, len(lines))
+	for i, line := range lines {
+		if !asHex {
+			bins[i] = []byte(line)
+			continue
+		}
+		b, err := hex.DecodeString(line)
+		if err != nil {
+			results[i].Error = err.Error()
+		}
+		bins[i] = b
+	}
+	for i, s := range base58.EncodeBatchAlphabet(bins, alphabet) {
+		if results[i].Error == "" {
+			results[i].Output = s
+		}
+	}
+}
+
+// batchDecode decodes lines into results, writing the bytes as hex.
+func batchDecode(lines []string, results []batchResult, alphabet *base58.Alphabet) {
+	bins, errs := base58.DecodeBatchAlphabet(lines, alphabet)
+	for _, err := range errs {
+		be := err.(*base58.BatchError)
+		results[be.Index].Error = be.Err.Error()
+	}
+	for i, b := range bins {
+		if results[i].Error != "" {
+			continue
+		}
+		results[i].Output = hex.EncodeToString(b)
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestBatch(t *testing.T) {
+	tests := []struct {
+		stdin string
+		args  []string
+		code  int
+		out   string
+	}{
+		{"48656c6c6f20576f726c6421\n00ff\n", []string{"batch", "encode"}, 0,
+			"1\t2NEpo7TZRRrLZSi2U\t\n2\t15Q\t\n"},
+		{"Hello World!\r\n", []string{"batch", "-bytes", "raw", "encode"}, 0,
+			"1\t2NEpo7TZRRrLZSi2U\t\n"},
+		{"zz\n", []string{"batch", "encode"}, 1,
+			"1\t\tencoding/hex: invalid byte: U+007A 'z'\n"},
+		{"2NEpo7TZRRrLZSi2U\n0OIl\n15Q\n", []string{"batch", "decode"}, 1,
+			"1\t48656c6c6f20576f726c6421\t\n2\t\tinvalid base58 digit ('0')\n3\t00ff\t\n"},
+		{"2nePN7syqqRkyrH2t\n", []string{"batch", "-alphabet", "flickr", "-format", "jsonl", "decode"}, 0,
+			`{"line":1,"output":"48656c6c6f20576f726c6421"}` + "\n"},
+		{"5Q\n", []string{"batch", "-bytes", "raw", "decode"}, exitUsage, ""},
+		{"0\n", []string{"batch", "-format", "jsonl", "decode"}, 1,
+			`{"line":1,"error":"invalid base58 digit ('0')"}` + "\n"},
+		{"", []string{"batch", "encode"}, 0, ""},
+		{"", []string{"batch"}, exitUsage, ""},
+		{"", []string{"batch", "-format", "csv", "encode"}, exitUsage, ""},
+	}
+	for _, tt := range tests {
+		code, out, errOut := runString(t, tt.stdin, tt.args...)
+		if code != tt.code || out != tt.out {
+			t.Errorf("%q: got %d %q (%s), want %d %q", tt.args, code, out, errOut, tt.code, tt.out)
+		}
+	}
+}
+
+func TestBatchManyLines(t *testing.T) {
+	// Span several library batches and check line numbers carry over.
+	n := 2*batchLines + 10
+	var in strings.Builder
+	for i := 0; i < n; i++ {
+		if i == batchLines+3 {
+			in.WriteString("bad!\n")
+			continue
+		}
+		fmt.Fprintf(&in, "%04x\n", i)
+	}
+	code, out, _ := runString(t, in.String(), "batch", "encode")
+	if code != exitFailure {
+		t.Errorf("got exit status %d", code)
+	}
+	lines := strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+	if len(lines) != n {
+		t.Fatalf("got %d lines, want %d", len(lines), n)
+	}
+	bad := fmt.Sprintf("%d\t\t", batchLines+4)
+	if !strings.HasPrefix(lines[batchLines+3], bad) {
+		t.Errorf("got %q, want prefix %q", lines[batchLines+3], bad)
+	}
+	if want := fmt.Sprintf("%d\t", n); !strings.HasPrefix(lines[n-1], want) {
+		t.Errorf("got last line %q", lines[n-1])
+	}
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"bufio"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mr-tron/base58"
+)
+
+// format converts between bytes and one textual representation.
+type format struct {
+	decode func(string) ([]byte, error)
+	encode func([]byte) string
+	raw    bool // binary, so input is taken as is and output is not newline terminated
+}
+
+var formats = map[string]*format{
+	"raw": {
+		decode: func(s string) ([]byte, error) { return []byte(s), nil },
+		encode: func(b []byte) string { return string(b) },
+		raw:    true,
+	},
+	"hex": {
+		decode: hex.DecodeString,
+		encode: hex.EncodeToString,
+	},
+	"base64": {
+		decode: func(s string) ([]byte, error) {
+			return base64.RawStdEncoding.DecodeString(strings.TrimRight(s, "="))
+		},
+		encode: base64.StdEncoding.EncodeToString,
+	},
+	"base64url": {
+		decode: func(s string) ([]byte, error) {
+			return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+		},
+		encode: base64.RawURLEncoding.EncodeToString,
+	},
+	"base32": {
+		decode: func(s string) ([]byte, error) {
+			return base32.StdEncoding.DecodeString(strings.ToUpper(s))
+		},
+		encode: base32.StdEncoding.EncodeToString,
+	},
+	"multibase": {
+		decode: decodeMultibase,
+		encode: func(b []byte) string { return "z" + base58.Encode(b) },
+	},
+}
+
+// multibases are the multibase prefixes understood when decoding.
+var multibases = map[byte]func(string) ([]byte, error){
+	'z': base58.Decode,
+	'Z': func(s string) ([]byte, error) { return base58.DecodeAlphabet(s, base58.FlickrAlphabet) },
+	'f': hex.DecodeString,
+	'F': hex.DecodeString,
+	'm': base64.RawStdEncoding.DecodeString,
+	'M': base64.StdEncoding.DecodeString,
+	'u': base64.RawURLEncoding.DecodeString,
+	'U': base64.URLEncoding.DecodeString,
+	'b': func(s string) ([]byte, error) {
+		return base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(strings.ToUpper(s))
+	},
+	'B': base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString,
+	'c': func(s string) ([]byte, error) { return base32.StdEncoding.DecodeString(strings.ToUpper(s)) },
+	'C': base32.StdEncoding.DecodeString,
+}
+
+func decodeMultibase(s string) ([]byte, error) {
+	if s == "" {
+		return nil, errors.New("empty multibase string")
+	}
+	dec, ok := multibases[s[0]]
+	if !ok {
+		return nil, fmt.Errorf("unsupported multibase prefix %q", s[0])
+	}
+	return dec(s[1:])
+}
+
+// parseFormat returns the format called name. Base58 is "base58" for the
+// bitcoin alphabet or "base58:<alphabet>" for any other.
+func parseFormat(name string) (*format, error) {
+	if f, ok := formats[strings.ToLower(name)]; ok {
+		return f, nil
+	}
+	alph := base58.BTCAlphabet
+	switch {
+	case strings.ToLower(name) == "base58":
+	case strings.HasPrefix(strings.ToLower(name), "base58:"):
+		var err error
+		if alph, err = parseAlphabet(name[len("base58:"):]); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown format %q", name)
+	}
+	return &format{
+		decode: func(s string) ([]byte, error) { return base58.DecodeAlphabet(s, alph) },
+		encode: func(b []byte) string { return base58.EncodeAlphabet(b, alph) },
+	}, nil
+}
+
+// formatFlag is a flag.Value holding a format.
+type formatFlag struct {
+	name   string
+	format *format
+}
+
+func (f *formatFlag) String() string { return f.name }
+
+func (f *formatFlag) Set(s string) error {
+	format, err := parseFormat(s)
+	if err != nil {
+		return err
+	}
+	f.name, f.format = s, format
+	return nil
+}
+
+func runConvert(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := newFlagSet("convert", stderr)
+	from := &formatFlag{name: "raw", format: formats["raw"]}
+	to := &formatFlag{name: "base58"}
+	to.format, _ = parseFormat("base58")
+	const formatHelp = "raw, hex, base64, base64url, base32, base58, base58:<alphabet> or multibase"
+	fs.Var(from, "from", "input `format`: "+formatHelp)
+	fs.Var(to, "to", "output `format`: "+formatHelp)
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+
+	code := exitOK
+	convert := func(where string, line string) {
+		if !from.format.raw {
+			// Ignore whitespace, as in grouped hex dumps.
+			line = strings.Join(strings.Fields(line), "")
+		}
+		b, err := from.format.decode(line)
+		if err != nil {
+			fmt.Fprintf(stderr, "base58 convert: %s: %v\n", where, err)
+			code = exitFailure
+			return
+		}
+		io.WriteString(stdout, to.format.encode(b))
+		if !to.format.raw {
+			io.WriteString(stdout, "\n")
+		}
+	}
+
+	if fs.NArg() > 0 {
+		for i, arg := range fs.Args() {
+			convert(fmt.Sprintf("argument %d", i+1), arg)
+		}
+		return code
+	}
+
+	sc := bufio.NewScanner(stdin)
+	sc.Buffer(nil, maxLine)
+	for n := 1; sc.Scan(); n++ {
+		convert(fmt.Sprintf("line %d", n), sc.Text())
+	}
+	if err := sc.Err(); err != nil {
+		fmt.Fprintf(stderr, "base58 convert: %v\n", err)
+		return exitFailure
+	}
+	return code
+}
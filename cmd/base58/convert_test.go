@@ -0,0 +1,33 @@
+package main
+
+import "testing"
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		stdin string
+		args  []string
+		code  int
+		out   string
+	}{
+		{"", []string{"convert", "-from", "hex", "-to", "base58", "48656c6c6f20576f726c6421"}, 0, "2NEpo7TZRRrLZSi2U\n"},
+		{"", []string{"convert", "-from", "hex", "48 65 6c 6c 6f 20 57 6f 72 6c 64 21"}, 0, "2NEpo7TZRRrLZSi2U\n"},
+		{"", []string{"convert", "Hello World!"}, 0, "2NEpo7TZRRrLZSi2U\n"},
+		{"", []string{"convert", "-from", "base58", "-to", "hex", "2NEpo7TZRRrLZSi2U"}, 0, "48656c6c6f20576f726c6421\n"},
+		{"", []string{"convert", "-from", "base58", "-to", "base58:flickr", "2NEpo7TZRRrLZSi2U"}, 0, "2nePN7syqqRkyrH2t\n"},
+		{"", []string{"convert", "-from", "base64", "-to", "base58", "SGVsbG8gV29ybGQh"}, 0, "2NEpo7TZRRrLZSi2U\n"},
+		{"", []string{"convert", "-from", "hex", "-to", "base64url", "fbff"}, 0, "-_8\n"},
+		{"", []string{"convert", "-from", "base58", "-to", "base32", "2NEpo7TZRRrLZSi2U"}, 0, "JBSWY3DPEBLW64TMMQQQ====\n"},
+		{"", []string{"convert", "-from", "base32", "-to", "raw", "jbswy3dpeblw64tmmqqq===="}, 0, "Hello World!"},
+		{"", []string{"convert", "-from", "hex", "-to", "multibase", "00ff"}, 0, "z15Q\n"},
+		{"", []string{"convert", "-from", "multibase", "-to", "hex", "z15Q", "f00ff", "mAP8", "BAD7Q"}, 0, "00ff\n00ff\n00ff\n00ff\n"},
+		{"00\nzz\n01\n", []string{"convert", "-from", "hex", "-to", "base58"}, 1, "1\n2\n"},
+		{"", []string{"convert", "-from", "multibase", "?abc"}, 1, ""},
+		{"", []string{"convert", "-to", "base99", "x"}, exitUsage, ""},
+	}
+	for _, tt := range tests {
+		code, out, errOut := runString(t, tt.stdin, tt.args...)
+		if code != tt.code || out != tt.out {
+			t.Errorf("%q: got %d %q (%s), want %d %q", tt.args, code, out, errOut, tt.code, tt.out)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/mr-tron/base58"
+	"github.com/mr-tron/base58/internal/ripemd160"
+)
+
+// inspection is the report of the inspect command.
+type inspection struct {
+	Input  string `json:"input"`
+	Length int    `json:"length"`
+	// Alphabet is the alphabet of the most likely candidate, empty if s
+	// matches no known format.
+	Alphabet     string            `json:"alphabet,omitempty"`
+	DecodesUnder []alphabetReport  `json:"decodes_under"`
+	Candidates   []candidateReport `json:"candidates"`
+}
+
+// alphabetReport describes s decoded with one alphabet that accepts it. Most
+// strings decode under every alphabet, so this says nothing about which one
+// was used.
+type alphabetReport struct {
+	Name         string          `json:"name"`
+	Bytes        int             `json:"bytes"`
+	Hex          string          `json:"hex"`
+	LeadingZeros int             `json:"leading_zeros"`
+	Checksums    map[string]bool `json:"checksums"`
+}
+
+type candidateReport struct {
+	Format     string            `json:"format"`
+	Alphabet   string            `json:"alphabet"`
+	Confidence int               `json:"confidence"`
+	Fields     map[string]string `json:"fields,omitempty"`
+}
+
+// inspectAlphabets are the alphabets tried, in report order.
+var inspectAlphabets = []struct {
+	name     string
+	alphabet *base58.Alphabet
+}{
+	{"btc", base58.BTCAlphabet},
+	{"flickr", base58.FlickrAlphabet},
+	{"ripple", base58.RippleAlphabet},
+}
+
+func inspect(s string) *inspection {
+	r := &inspection{Input: s, Length: len(s), DecodesUnder: []alphabetReport{}, Candidates: []candidateReport{}}
+
+	for _, a := range inspectAlphabets {
+		raw, err := base58.DecodeAlphabet(s, a.alphabet)
+		if err != nil {
+			continue
+		}
+		zeros := 0
+		for zeros < len(raw) && raw[zeros] == 0 {
+			zeros++
+		}
+		sums := map[string]bool{
+			"sha256d":   checkSHA256d(s, a.alphabet),
+			"ripemd160": checkRIPEMD160(raw),
+		}
+		if a.alphabet == base58.BTCAlphabet {
+			_, err := base58.SS58Decode(s)
+			sums["ss58"] = err == nil
+		}
+		r.DecodesUnder = append(r.DecodesUnder, alphabetReport{
+			Name:         a.name,
+			Bytes:        len(raw),
+			Hex:          hex.EncodeToString(raw),
+			LeadingZeros: zeros,
+			Checksums:    sums,
+		})
+	}
+
+	for _, c := range base58.Identify(s) {
+		r.Candidates = append(r.Candidates, candidateReport{
+			Format:     c.Format,
+			Alphabet:   c.Alphabet,
+			Confidence: c.Confidence,
+			Fields:     c.Fields,
+		})
+	}
+	if len(r.Candidates) > 0 && r.Candidates[0].Format != "base58" {
+		r.Alphabet = r.Candidates[0].Alphabet
+	}
+	return r
+}
+
+// checkSHA256d reports whether s carries a Base58Check checksum.
+func checkSHA256d(s string, alphabet *base58.Alphabet) bool {
+	_, err := base58.CheckDecodeAlphabet(s, alphabet)
+	return err == nil
+}
+
+// checkRIPEMD160 reports whether raw ends in the first four bytes of the
+// RIPEMD-160 of the rest, as in legacy EOS keys.
+func checkRIPEMD160(raw []byte) bool {
+	if len(raw) <= 4 {
+		return false
+	}
+	payload := raw[:len(raw)-4]
+	sum := ripemd160.Sum(payload)
+	return bytes.Equal(sum[:4], raw[len(payload):])
+}
+
+func (r *inspection) writeText(w io.Writer) {
+	fmt.Fprintf(w, "input:    %s\n", r.Input)
+	fmt.Fprintf(w, "length:   %d characters\n", r.Length)
+	if len(r.DecodesUnder) == 0 && len(r.Candidates) == 0 {
+		fmt.Fprintln(w, "not valid base58 in any known alphabet or format")
+		return
+	}
+	if r.Alphabet != "" {
+		fmt.Fprintf(w, "alphabet: %s\n", r.Alphabet)
+	}
+	for _, a := range r.DecodesUnder {
+		fmt.Fprintf(w, "\ndecodes under %s:\n", a.Name)
+		fmt.Fprintf(w, "  bytes:         %d\n", a.Bytes)
+		fmt.Fprintf(w, "  hex:           %s\n", a.Hex)
+		fmt.Fprintf(w, "  leading zeros: %d\n", a.LeadingZeros)
+		fmt.Fprintf(w, "  checksums:     %s\n", formatChecksums(a.Checksums))
+	}
+	if len(r.Candidates) > 0 {
+		fmt.Fprintln(w, "\ncandidates:")
+	}
+	for _, c := range r.Candidates {
+		fmt.Fprintf(w, "  %s (%s, confidence %d)\n", c.Format, c.Alphabet, c.Confidence)
+		keys := make([]string, 0, len(c.Fields))
+		for k := range c.Fields {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(w, "    %s: %s\n", k, c.Fields[k])
+		}
+	}
+}
+
+func formatChecksums(sums map[string]bool) string {
+	names := make([]string, 0, len(sums))
+	for name := range sums {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	parts := make([]string, len(names))
+	for i, name := range names {
+		state := "invalid"
+		if sums[name] {
+			state = "valid"
+		}
+		parts[i] = name + " " + state
+	}
+	return strings.Join(parts, ", ")
+}
+
+func runInspect(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := newFlagSet("inspect", stderr)
+	asJSON := fs.Bool("json", false, "write one JSON object per input")
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+
+	in := fs.Args()
+	if len(in) == 0 {
+		b, err := ioutil.ReadAll(stdin)
+		if err != nil {
+			fmt.Fprintf(stderr, "base58 inspect: %v\n", err)
+			return exitFailure
+		}
+		in = strings.Fields(string(b))
+	}
+
+	code := exitOK
+	enc := json.NewEncoder(stdout)
+	for i, s := range in {
+		r := inspect(s)
+		if len(r.DecodesUnder) == 0 && len(r.Candidates) == 0 {
+			code = exitFailure
+		}
+		if *asJSON {
+			enc.Encode(r)
+			continue
+		}
+		if i > 0 {
+			fmt.Fprintln(stdout)
+		}
+		r.writeText(stdout)
+	}
+	return code
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestInspectJSON(t *testing.T) {
+	code, out, _ := runString(t, "", "inspect", "-json", "1QCaxc8hutpdZ62iKZsn1TCG3nh7uPZojq")
+	if code != 0 {
+		t.Fatalf("got exit status %d", code)
+	}
+	var r inspection
+	if err := json.Unmarshal([]byte(out), &r); err != nil {
+		t.Fatal(err)
+	}
+	if r.Alphabet != "btc" {
+		t.Errorf("got alphabet %q", r.Alphabet)
+	}
+	if len(r.DecodesUnder) != 3 || r.DecodesUnder[0].Name != "btc" {
+		t.Fatalf("got decodes under %+v", r.DecodesUnder)
+	}
+	btc := r.DecodesUnder[0]
+	if btc.Bytes != 25 || btc.LeadingZeros != 1 || !btc.Checksums["sha256d"] || btc.Checksums["ripemd160"] {
+		t.Errorf("got %+v", btc)
+	}
+	if len(r.Candidates) == 0 || r.Candidates[0].Format != "address" ||
+		r.Candidates[0].Fields["network"] != "bitcoin" {
+		t.Errorf("got candidates %+v", r.Candidates)
+	}
+}
+
+func TestInspectText(t *testing.T) {
+	code, out, _ := runString(t, "EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV\n", "inspect")
+	if code != 0 || !strings.Contains(out, "eos-key (btc") {
+		t.Errorf("got %d\n%s", code, out)
+	}
+
+	code, out, _ = runString(t, "", "inspect", "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh")
+	if code != 0 || !strings.Contains(out, "alphabet: ripple\n") || !strings.Contains(out, "decodes under btc:") {
+		t.Errorf("got %d\n%s", code, out)
+	}
+
+	// A string matching no format has no detected alphabet.
+	code, out, _ = runString(t, "", "inspect", "3mJr7AoUXx2Wqd")
+	if code != 0 || strings.Contains(out, "alphabet:") {
+		t.Errorf("got %d\n%s", code, out)
+	}
+
+	code, out, _ = runString(t, "", "inspect", "0OIl")
+	if code != exitFailure || !strings.Contains(out, "not valid base58") {
+		t.Errorf("got %d\n%s", code, out)
+	}
+}
+
+func TestChecksumRIPEMD160(t *testing.T) {
+	// Legacy EOS public keys carry a RIPEMD-160 checksum.
+	code, out, _ := runString(t, "", "inspect", "-json", "6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV")
+	var r inspection
+	if code != 0 || json.Unmarshal([]byte(out), &r) != nil || !r.DecodesUnder[0].Checksums["ripemd160"] {
+		t.Errorf("got %d %s", code, out)
+	}
+}
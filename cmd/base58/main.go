@@ -0,0 +1,296 @@
+// Command base58 encodes, decodes and validates base58 data.
+//
+// Usage:
+//
+//	base58 <command> [flags] [arguments]
+//
+// Run "base58 help" for the list of commands. Every command accepts
+// -alphabet, either the name of a known alphabet (btc, flickr, ripple) or a
+// literal 58-character alphabet.
+//
+// The exit status is 0 on success, 1 if any input could not be processed and
+// 2 for usage errors. The wif command exits with 3 on a checksum mismatch, as
+// the example program it replaces did.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/mr-tron/base58"
+)
+
+const (
+	exitOK      = 0
+	exitFailure = 1
+	exitUsage   = 2
+)
+
+// maxLine is the longest input line accepted by the line-oriented commands.
+const maxLine = 64 << 20
+
+type command struct {
+	name    string
+	args    string
+	summary string
+	run     func(args []string, stdin io.Reader, stdout, stderr io.Writer) int
+}
+
+var commands []*command
+
+func init() {
+	// Commands that need a newer Go release register themselves from their
+	// own files; keep these first whichever init runs first.
+	commands = append([]*command{
+		{"encode", "[data...]", "encode each argument, or all of stdin, as base58", runEncode},
+		{"decode", "[string...]", "decode each argument, or stdin, to raw bytes", runDecode},
+		{"check-encode", "[data...]", "like encode, appending a Base58Check checksum", runCheckEncode},
+		{"check-decode", "[string...]", "like decode, verifying and removing a Base58Check checksum", runCheckDecode},
+		{"validate", "[string...]", "check each argument, or each word of stdin, is valid base58", runValidate},
+		{"convert", "[value...]", "convert each argument, or line of stdin, between formats", runConvert},
+		{"inspect", "[string...]", "describe the encoding and structure of each argument, or word of stdin", runInspect},
+		{"batch", "encode|decode", "convert each line of stdin independently, reporting errors per line", runBatch},
+		{"wif", "", "encode or decode a file with line wrapping and an optional checksum", runWIF},
+	}, commands...)
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run executes the command line args and returns the exit status.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return exitUsage
+	}
+	switch args[0] {
+	case "help", "-h", "-help", "--help":
+		usage(stdout)
+		return exitOK
+	}
+	if c := lookupCommand(args[0]); c != nil {
+		return c.run(args[1:], stdin, stdout, stderr)
+	}
+	fmt.Fprintf(stderr, "base58: unknown command %q\n", args[0])
+	usage(stderr)
+	return exitUsage
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: base58 <command> [flags] [arguments]")
+	fmt.Fprintln(w, "\ncommands:")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-13s %s\n", c.name, c.summary)
+	}
+	fmt.Fprintln(w, "\nRun \"base58 <command> -h\" for the flags of a command.")
+}
+
+// newFlagSet returns the flag set for the named command.
+func newFlagSet(name string, stderr io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	if c := lookupCommand(name); c != nil {
+		fs.Usage = func() {
+			fmt.Fprintf(stderr, "usage: base58 %s [flags] %s\n\n%s\n\nflags:\n", c.name, c.args, c.summary)
+			fs.PrintDefaults()
+		}
+	}
+	return fs
+}
+
+// lookupCommand returns the command called name, or nil.
+func lookupCommand(name string) *command {
+	for _, c := range commands {
+		if c.name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// parseFlags parses args into fs, returning false with the exit status to
+// use if the command should not run.
+func parseFlags(fs *flag.FlagSet, args []string) (int, bool) {
+	switch err := fs.Parse(args); err {
+	case nil:
+		return exitOK, true
+	case flag.ErrHelp:
+		return exitOK, false
+	default:
+		return exitUsage, false
+	}
+}
+
+// alphabets are the alphabets known by name.
+var alphabets = map[string]*base58.Alphabet{
+	"btc":     base58.BTCAlphabet,
+	"bitcoin": base58.BTCAlphabet,
+	"flickr":  base58.FlickrAlphabet,
+	"ripple":  base58.RippleAlphabet,
+	"xrp":     base58.RippleAlphabet,
+}
+
+// alphabetFlag is a flag.Value holding an alphabet given by name or as a
+// literal.
+type alphabetFlag struct {
+	name     string
+	alphabet *base58.Alphabet
+}
+
+func newAlphabetFlag(fs *flag.FlagSet) *alphabetFlag {
+	f := &alphabetFlag{name: "btc", alphabet: base58.BTCAlphabet}
+	fs.Var(f, "alphabet", "alphabet `name` (btc, flickr, ripple) or 58-character literal")
+	return f
+}
+
+func (f *alphabetFlag) String() string { return f.name }
+
+func (f *alphabetFlag) Set(s string) error {
+	a, err := parseAlphabet(s)
+	if err != nil {
+		return err
+	}
+	f.name, f.alphabet = s, a
+	return nil
+}
+
+// parseAlphabet returns the alphabet called s, or the literal alphabet s.
+func parseAlphabet(s string) (*base58.Alphabet, error) {
+	if a, ok := alphabets[strings.ToLower(s)]; ok {
+		return a, nil
+	}
+	if len(s) != 58 {
+		names := make([]string, 0, len(alphabets))
+		for name := range alphabets {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("unknown alphabet %q (known: %s)", s, strings.Join(names, ", "))
+	}
+	var seen [128]bool
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 128 || s[i] <= ' ' || seen[s[i]] {
+			return nil, fmt.Errorf("alphabet must be 58 distinct printable ASCII characters")
+		}
+		seen[s[i]] = true
+	}
+	return base58.NewAlphabet(s), nil
+}
+
+// inputs returns the arguments, or all of stdin as a single input.
+func inputs(args []string, stdin io.Reader) ([][]byte, error) {
+	if len(args) > 0 {
+		in := make([][]byte, len(args))
+		for i, a := range args {
+			in[i] = []byte(a)
+		}
+		return in, nil
+	}
+	b, err := ioutil.ReadAll(stdin)
+	if err != nil {
+		return nil, err
+	}
+	return [][]byte{b}, nil
+}
+
+func runEncode(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	return encode("encode", args, stdin, stdout, stderr, base58.EncodeAlphabet)
+}
+
+func runCheckEncode(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	return encode("check-encode", args, stdin, stdout, stderr, base58.CheckEncodeAlphabet)
+}
+
+func encode(name string, args []string, stdin io.Reader, stdout, stderr io.Writer, enc func([]byte, *base58.Alphabet) string) int {
+	fs := newFlagSet(name, stderr)
+	alph := newAlphabetFlag(fs)
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+
+	in, err := inputs(fs.Args(), stdin)
+	if err != nil {
+		fmt.Fprintf(stderr, "base58 %s: %v\n", name, err)
+		return exitFailure
+	}
+	for _, b := range in {
+		fmt.Fprintln(stdout, enc(b, alph.alphabet))
+	}
+	return exitOK
+}
+
+func runDecode(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	return decode("decode", args, stdin, stdout, stderr, base58.DecodeAlphabet)
+}
+
+func runCheckDecode(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	return decode("check-decode", args, stdin, stdout, stderr, base58.CheckDecodeAlphabet)
+}
+
+func decode(name string, args []string, stdin io.Reader, stdout, stderr io.Writer, dec func(string, *base58.Alphabet) ([]byte, error)) int {
+	fs := newFlagSet(name, stderr)
+	alph := newAlphabetFlag(fs)
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+
+	in, err := inputs(fs.Args(), stdin)
+	if err != nil {
+		fmt.Fprintf(stderr, "base58 %s: %v\n", name, err)
+		return exitFailure
+	}
+	for _, s := range in {
+		b, err := dec(strings.TrimSpace(string(s)), alph.alphabet)
+		if err != nil {
+			fmt.Fprintf(stderr, "base58 %s: %v\n", name, err)
+			return exitFailure
+		}
+		stdout.Write(b)
+	}
+	return exitOK
+}
+
+func runValidate(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := newFlagSet("validate", stderr)
+	alph := newAlphabetFlag(fs)
+	check := fs.Bool("check", false, "also require a valid Base58Check checksum")
+	quiet := fs.Bool("q", false, "only set the exit status")
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+
+	in := fs.Args()
+	if len(in) == 0 {
+		b, err := ioutil.ReadAll(stdin)
+		if err != nil {
+			fmt.Fprintf(stderr, "base58 validate: %v\n", err)
+			return exitFailure
+		}
+		in = strings.Fields(string(b))
+	}
+
+	code := exitOK
+	for _, s := range in {
+		var err error
+		if *check {
+			_, err = base58.CheckDecodeAlphabet(s, alph.alphabet)
+		} else {
+			_, err = base58.DecodeAlphabet(s, alph.alphabet)
+		}
+		if err != nil {
+			code = exitFailure
+			if !*quiet {
+				fmt.Fprintf(stdout, "%s\tinvalid: %v\n", s, err)
+			}
+		} else if !*quiet {
+			fmt.Fprintf(stdout, "%s\tvalid\n", s)
+		}
+	}
+	return code
+}
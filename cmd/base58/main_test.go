@@ -0,0 +1,98 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// runString runs the command line with the given stdin and returns its exit
+// status and output.
+func runString(t *testing.T, stdin string, args ...string) (int, string, string) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	code := run(args, strings.NewReader(stdin), &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestEncodeDecode(t *testing.T) {
+	tests := []struct {
+		stdin string
+		args  []string
+		code  int
+		out   string
+	}{
+		{"", []string{"encode", "Hello World!"}, 0, "2NEpo7TZRRrLZSi2U\n"},
+		{"Hello World!", []string{"encode"}, 0, "2NEpo7TZRRrLZSi2U\n"},
+		{"", []string{"encode", "-alphabet", "flickr", "Hello World!"}, 0, "2nePN7syqqRkyrH2t\n"},
+		{"", []string{"encode", "a", "b"}, 0, "2g\n2h\n"},
+		{"", []string{"decode", "2NEpo7TZRRrLZSi2U"}, 0, "Hello World!"},
+		{"2NEpo7TZRRrLZSi2U\n", []string{"decode"}, 0, "Hello World!"},
+		{"", []string{"decode", "0OIl"}, 1, ""},
+		{"", []string{"check-encode", "Hello World!"}, 0, "9wWTEnNTUzJGD7cXz99ejY\n"},
+		{"", []string{"check-decode", "9wWTEnNTUzJGD7cXz99ejY"}, 0, "Hello World!"},
+		{"", []string{"check-decode", "9wWTEnNTUzJGD7cXz99ejZ"}, 1, ""},
+		{"", []string{"check-decode", "11"}, 1, ""},
+	}
+	for _, tt := range tests {
+		code, out, errOut := runString(t, tt.stdin, tt.args...)
+		if code != tt.code || out != tt.out {
+			t.Errorf("%q: got %d %q (%s), want %d %q", tt.args, code, out, errOut, tt.code, tt.out)
+		}
+	}
+}
+
+func TestAlphabetLiteral(t *testing.T) {
+	lit := "123456789abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ"
+	code, out, _ := runString(t, "", "encode", "--alphabet", lit, "Hello World!")
+	if code != 0 || out != "2nePN7syqqRkyrH2t\n" {
+		t.Errorf("got %d %q", code, out)
+	}
+
+	for _, bad := range []string{"nope", strings.Repeat("a", 58)} {
+		if code, _, _ := runString(t, "", "encode", "-alphabet", bad, "x"); code != exitUsage {
+			t.Errorf("%q: got exit status %d", bad, code)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	code, out, _ := runString(t, "2NEpo7TZRRrLZSi2U\n9wWTEnNTUzJGD7cXz99ejY\n", "validate", "-check")
+	if code != exitFailure {
+		t.Errorf("got exit status %d", code)
+	}
+	want := "2NEpo7TZRRrLZSi2U\tinvalid: checksum mismatch\n9wWTEnNTUzJGD7cXz99ejY\tvalid\n"
+	if out != want {
+		t.Errorf("got %q, want %q", out, want)
+	}
+
+	if code, out, _ := runString(t, "", "validate", "-q", "2NEpo7TZRRrLZSi2U"); code != 0 || out != "" {
+		t.Errorf("got %d %q", code, out)
+	}
+}
+
+func TestUsage(t *testing.T) {
+	for _, args := range [][]string{nil, {"frobnicate"}, {"encode", "-nope"}} {
+		if code, _, _ := runString(t, "", args...); code != exitUsage {
+			t.Errorf("%q: got exit status %d", args, code)
+		}
+	}
+	if code, out, _ := runString(t, "", "help"); code != 0 || !strings.Contains(out, "check-decode") {
+		t.Errorf("help: got %d %q", code, out)
+	}
+	if code, _, _ := runString(t, "", "decode", "-h"); code != 0 {
+		t.Errorf("decode -h: got exit status %d", code)
+	}
+}
+
+func TestCommandUsage(t *testing.T) {
+	for _, c := range commands {
+		for _, args := range [][]string{{c.name, "-h"}, {c.name, "-no-such-flag"}} {
+			_, _, errOut := runString(t, "", args...)
+			if want := "usage: base58 " + c.name + " "; !strings.HasPrefix(errOut, want) &&
+				!strings.Contains(errOut, "\n"+want) {
+				t.Errorf("%q: got usage %q, want it to start with %q", args, errOut, want)
+			}
+		}
+	}
+}
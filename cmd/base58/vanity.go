@@ -0,0 +1,96 @@
+//go:build go1.13
+// +build go1.13
+
+package main
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"time"
+
+	"github.com/mr-tron/base58"
+)
+
+func init() {
+	commands = append(commands, &command{
+		"vanity", "-prefix P | -suffix S",
+		"generate a Solana keypair whose address matches a pattern", runVanity,
+	})
+}
+
+func runVanity(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := newFlagSet("vanity", stderr)
+	var p base58.VanityPattern
+	fs.StringVar(&p.Prefix, "prefix", "", "wanted start of the address")
+	fs.StringVar(&p.Suffix, "suffix", "", "wanted end of the address")
+	fs.BoolVar(&p.IgnoreCase, "ignore-case", false, "match the pattern case-insensitively")
+	out := fs.String("o", "", "keypair `file` to write (default <address>.json)")
+	timeout := fs.Duration("timeout", 0, "give up after this long (default never)")
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+	if fs.NArg() > 0 || (p.Prefix == "" && p.Suffix == "") {
+		fs.Usage()
+		return exitUsage
+	}
+	if err := p.Validate(); err != nil {
+		fmt.Fprintf(stderr, "base58 vanity: %v: use characters of %q only\n", err, alphabetChars)
+		return exitUsage
+	}
+
+	attempts := p.ExpectedAttempts()
+	if math.IsInf(attempts, 1) {
+		fmt.Fprintln(stderr, "base58 vanity: no 32-byte key can match this pattern")
+		return exitUsage
+	}
+
+	ctx := context.Background()
+	if *timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	fmt.Fprintf(stderr, "searching, expecting about %.0f attempts\n", attempts)
+	start := time.Now()
+	priv, err := base58.VanityKey(ctx, &p)
+	if err != nil {
+		fmt.Fprintf(stderr, "base58 vanity: %v\n", err)
+		return exitFailure
+	}
+	addr := base58.Encode(priv.Public().(ed25519.PublicKey))
+
+	path := *out
+	if path == "" {
+		path = addr + ".json"
+	}
+	if err := writeKeypair(path, priv); err != nil {
+		fmt.Fprintf(stderr, "base58 vanity: %v\n", err)
+		return exitFailure
+	}
+	fmt.Fprintf(stderr, "found in %v, keypair written to %s\n", time.Since(start).Round(time.Millisecond), path)
+	fmt.Fprintln(stdout, addr)
+	return exitOK
+}
+
+// alphabetChars are the characters allowed in a vanity pattern.
+const alphabetChars = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// writeKeypair writes priv as solana-keygen does: a JSON array of the 64
+// bytes of the seed followed by the public key.
+func writeKeypair(path string, priv ed25519.PrivateKey) error {
+	ints := make([]int, len(priv))
+	for i, b := range priv {
+		ints[i] = int(b)
+	}
+	b, err := json.Marshal(ints)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, b, 0600)
+}
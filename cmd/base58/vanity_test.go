@@ -0,0 +1,65 @@
+//go:build go1.13
+// +build go1.13
+
+package main
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mr-tron/base58"
+)
+
+func TestVanity(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vanity")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "key.json")
+
+	code, out, errOut := runString(t, "", "vanity", "-prefix", "a", "-ignore-case", "-o", path)
+	if code != 0 {
+		t.Fatalf("got exit status %d: %s", code, errOut)
+	}
+	addr := strings.TrimSpace(out)
+	if !strings.EqualFold(addr[:1], "a") {
+		t.Errorf("got address %s", addr)
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ints []int
+	if err := json.Unmarshal(b, &ints); err != nil || len(ints) != ed25519.PrivateKeySize {
+		t.Fatalf("got %s, %v", b, err)
+	}
+	priv := make(ed25519.PrivateKey, len(ints))
+	for i, v := range ints {
+		priv[i] = byte(v)
+	}
+	if got := base58.Encode(ed25519.NewKeyFromSeed(priv.Seed()).Public().(ed25519.PublicKey)); got != addr {
+		t.Errorf("keypair is for %s, want %s", got, addr)
+	}
+}
+
+func TestVanityErrors(t *testing.T) {
+	if code, _, _ := runString(t, "", "vanity", "-prefix", "0"); code != exitUsage {
+		t.Errorf("invalid pattern: got exit status %d", code)
+	}
+	if code, _, _ := runString(t, "", "vanity", "-prefix", strings.Repeat("1", 33)); code != exitUsage {
+		t.Errorf("impossible pattern: got exit status %d", code)
+	}
+	if code, _, _ := runString(t, "", "vanity"); code != exitUsage {
+		t.Errorf("empty pattern: got exit status %d", code)
+	}
+	if code, _, _ := runString(t, "", "vanity", "-prefix", "zzzzzzzzzz", "-timeout", "10ms"); code != exitFailure {
+		t.Errorf("timeout: got exit status %d", code)
+	}
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/mr-tron/base58"
+	"github.com/mr-tron/base58/internal/blake2b"
+	"github.com/mr-tron/base58/internal/ripemd160"
+)
+
+// checksums are the algorithms of the wif command's -checksum flag, each
+// returning the four check bytes of a payload. Base58Check (sha256d) is left
+// to the library.
+var checksums = map[string]func([]byte) []byte{
+	"none":    nil,
+	"sha256d": nil,
+	"blake2b": func(b []byte) []byte {
+		h := blake2b.Sum512(b)
+		return h[:4]
+	},
+	"ripemd160": func(b []byte) []byte {
+		h := ripemd160.Sum(b)
+		return h[:4]
+	},
+}
+
+// exitChecksum is the exit status of the wif command for a checksum
+// mismatch.
+const exitChecksum = 3
+
+func runWIF(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := newFlagSet("wif", stderr)
+	alph := newAlphabetFlag(fs)
+	decode := fs.Bool("d", false, "decode input")
+	width := fs.Int("b", 76, "break encoded output into lines of this many characters, 0 to disable")
+	input := fs.String("i", "-", `input file ("-" for stdin)`)
+	output := fs.String("o", "-", `output file ("-" for stdout)`)
+	algo := fs.String("checksum", "none", "checksum `algorithm`: none, sha256d (Base58Check), blake2b or ripemd160")
+	check := fs.Bool("k", false, "shorthand for -checksum sha256d")
+	useError := fs.Bool("e", false, `write "false" to stderr when the checksum does not match`)
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+	if *check {
+		*algo = "sha256d"
+	}
+	sum, ok := checksums[*algo]
+	if fs.NArg() > 0 || !ok || *width < 0 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	fail := func(err error) int {
+		fmt.Fprintf(stderr, "base58 wif: %v\n", err)
+		return exitFailure
+	}
+
+	in := stdin
+	if *input != "-" {
+		f, err := os.Open(*input)
+		if err != nil {
+			return fail(err)
+		}
+		defer f.Close()
+		in = f
+	}
+	data, err := ioutil.ReadAll(in)
+	if err != nil {
+		return fail(err)
+	}
+
+	var result []byte
+	if *decode {
+		result, err = wifDecode(string(data), alph.alphabet, *algo, sum)
+	} else {
+		result = wifEncode(data, alph.alphabet, *algo, sum, *width)
+	}
+	if err == base58.ErrChecksum {
+		// Kept from the original example program.
+		if *useError {
+			fmt.Fprintln(stderr, false)
+		}
+		return exitChecksum
+	}
+	if err != nil {
+		return fail(err)
+	}
+
+	out := stdout
+	if *output != "-" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return fail(err)
+		}
+		defer f.Close()
+		out = f
+	}
+	if _, err := out.Write(result); err != nil {
+		return fail(err)
+	}
+	return exitOK
+}
+
+// wifEncode encodes data with its checksum, if any, as newline terminated
+// lines of at most width characters.
+func wifEncode(data []byte, alphabet *base58.Alphabet, algo string, sum func([]byte) []byte, width int) []byte {
+	var encoded string
+	switch {
+	case algo == "sha256d":
+		encoded = base58.CheckEncodeAlphabet(data, alphabet)
+	case sum != nil:
+		encoded = base58.EncodeAlphabet(append(append([]byte(nil), data...), sum(data)...), alphabet)
+	default:
+		encoded = base58.EncodeAlphabet(data, alphabet)
+	}
+
+	if width == 0 || len(encoded) <= width {
+		return []byte(encoded + "\n")
+	}
+	var buf bytes.Buffer
+	for len(encoded) > width {
+		buf.WriteString(encoded[:width])
+		buf.WriteByte('\n')
+		encoded = encoded[width:]
+	}
+	if encoded != "" {
+		buf.WriteString(encoded)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes()
+}
+
+// wifDecode decodes possibly wrapped input and verifies and strips its
+// checksum, if any.
+func wifDecode(s string, alphabet *base58.Alphabet, algo string, sum func([]byte) []byte) ([]byte, error) {
+	raw, err := base58.DecodeSkippingAlphabet(s, "", alphabet)
+	switch {
+	case err != nil:
+		return nil, err
+	case algo == "sha256d":
+		// Only digits and whitespace are left, so CheckDecode sees the same
+		// digits.
+		return base58.CheckDecodeAlphabet(strings.Join(strings.Fields(s), ""), alphabet)
+	case sum == nil:
+		return raw, nil
+	}
+	if len(raw) < 4 {
+		return nil, base58.ErrCheckLength
+	}
+	payload := raw[:len(raw)-4]
+	if !bytes.Equal(sum(payload), raw[len(payload):]) {
+		return nil, base58.ErrChecksum
+	}
+	return payload, nil
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mr-tron/base58"
+)
+
+func TestWIFWrap(t *testing.T) {
+	tests := []struct {
+		width string
+		want  string
+	}{
+		{"0", "2NEpo7TZRRrLZSi2U\n"},
+		{"76", "2NEpo7TZRRrLZSi2U\n"},
+		{"5", "2NEpo\n7TZRR\nrLZSi\n2U\n"},
+		{"17", "2NEpo7TZRRrLZSi2U\n"},
+		{"1", strings.Join(strings.Split("2NEpo7TZRRrLZSi2U", ""), "\n") + "\n"},
+	}
+	for _, tt := range tests {
+		code, out, errOut := runString(t, "Hello World!", "wif", "-b", tt.width)
+		if code != 0 || out != tt.want {
+			t.Errorf("-b %s: got %d %q (%s), want %q", tt.width, code, out, errOut, tt.want)
+		}
+	}
+}
+
+func TestWIFChecksums(t *testing.T) {
+	data := strings.Repeat("some payload ", 20)
+	for algo := range checksums {
+		code, encoded, errOut := runString(t, data, "wif", "-b", "20", "-checksum", algo)
+		if code != 0 {
+			t.Fatalf("%s: encode failed: %s", algo, errOut)
+		}
+		code, decoded, errOut := runString(t, encoded, "wif", "-d", "-checksum", algo)
+		if code != 0 || decoded != data {
+			t.Errorf("%s: got %d %q (%s)", algo, code, decoded, errOut)
+		}
+
+		if algo == "none" {
+			continue
+		}
+		// Corrupt the last digit.
+		last := strings.LastIndexAny(encoded, "123456789")
+		bad := encoded[:last] + "A" + encoded[last+1:]
+		if code, out, errOut := runString(t, bad, "wif", "-d", "-checksum", algo); code != exitChecksum || out != "" || errOut != "" {
+			t.Errorf("%s: corrupted input: got %d %q %q", algo, code, out, errOut)
+		}
+		if code, _, errOut := runString(t, bad, "wif", "-d", "-e", "-checksum", algo); code != exitChecksum || errOut != "false\n" {
+			t.Errorf("%s: corrupted input with -e: got %d %q", algo, code, errOut)
+		}
+		// Too short to hold a checksum.
+		if code, _, errOut := runString(t, "2g", "wif", "-d", "-checksum", algo); code != exitFailure ||
+			!strings.Contains(errOut, base58.ErrCheckLength.Error()) {
+			t.Errorf("%s: short input: got %d %q", algo, code, errOut)
+		}
+	}
+}
+
+func TestWIFMatchesCheckEncode(t *testing.T) {
+	payload := "\x80\x0c\x28\xfc\xa3\x86\xc7\xa2\x27\x60\x0b\x2f\xe5\x0b\x7c\xae"
+	want := base58.CheckEncode([]byte(payload)) + "\n"
+	for _, args := range [][]string{{"wif", "-b", "0", "-k"}, {"wif", "-b", "0", "-checksum", "sha256d"}} {
+		if _, out, _ := runString(t, payload, args...); out != want {
+			t.Errorf("%q: got %q, want %q", args, out, want)
+		}
+	}
+}
+
+func TestWIFEncodeKeepsInput(t *testing.T) {
+	buf := make([]byte, 3, 16)
+	copy(buf, "abc")
+	spare := buf[:cap(buf)]
+	wifEncode(buf, base58.BTCAlphabet, "blake2b", checksums["blake2b"], 0)
+	for i, c := range spare[len(buf):] {
+		if c != 0 {
+			t.Fatalf("wrote %#x past the input at %d", c, len(buf)+i)
+		}
+	}
+}
+
+func TestWIFFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wif")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	in, out := filepath.Join(dir, "in"), filepath.Join(dir, "out")
+	if err := ioutil.WriteFile(in, []byte("Hello World!"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if code, _, errOut := runString(t, "", "wif", "-i", in, "-o", out); code != 0 {
+		t.Fatalf("got exit status %d: %s", code, errOut)
+	}
+	if b, _ := ioutil.ReadFile(out); string(b) != "2NEpo7TZRRrLZSi2U\n" {
+		t.Errorf("got %q", b)
+	}
+	if code, _, _ := runString(t, "", "wif", "-i", filepath.Join(dir, "missing")); code != exitFailure {
+		t.Errorf("missing input: got exit status %d", code)
+	}
+	if code, _, _ := runString(t, "", "wif", "-checksum", "crc32"); code != exitUsage {
+		t.Errorf("unknown checksum: got exit status %d", code)
+	}
+}
+
+func TestWIFMatchesCheckDecode(t *testing.T) {
+	inputs := []string{
+		base58.CheckEncode([]byte("payload")),
+		base58.CheckEncode(nil),
+		base58.Encode([]byte("no checksum here")),
+		"2g",
+		"1",
+	}
+	for _, in := range inputs {
+		want, wantErr := base58.CheckDecode(in)
+		// Wrapping must not change the outcome.
+		wrapped := in[:len(in)/2] + "\n" + in[len(in)/2:] + "\n"
+		code, out, errOut := runString(t, wrapped, "wif", "-d", "-k")
+		switch {
+		case wantErr == base58.ErrChecksum:
+			if code != exitChecksum {
+				t.Errorf("%q: got exit status %d, want %d", in, code, exitChecksum)
+			}
+		case wantErr != nil:
+			if code != exitFailure || !strings.Contains(errOut, wantErr.Error()) {
+				t.Errorf("%q: got %d %q, want error %v", in, code, errOut, wantErr)
+			}
+		case code != 0 || out != string(want):
+			t.Errorf("%q: got %d %q, want %q", in, code, out, want)
+		}
+	}
+
+	// Invalid characters are reported at their offset in the input, with
+	// or without a checksum.
+	for _, args := range [][]string{{"wif", "-d"}, {"wif", "-d", "-k"}} {
+		_, _, errOut := runString(t, "2NEp\no0TZ", args...)
+		if want := base58.CorruptInputError(6).Error(); !strings.Contains(errOut, want) {
+			t.Errorf("%q: got %q, want %q", args, errOut, want)
+		}
+	}
+}
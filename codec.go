@@ -0,0 +1,122 @@
+package base58
+
+import "sync"
+
+// Codec encodes and decodes with a fixed alphabet, drawing the scratch space
+// of each call from a pool instead of allocating it. The Append methods do
+// not allocate at all when dst has room for the result. A Codec is safe for
+// concurrent use.
+type Codec struct {
+	alphabet *Alphabet
+	pool     sync.Pool
+}
+
+type codecScratch struct {
+	buf   []byte
+	limbs []uint32
+}
+
+// NewCodec returns a Codec using the passed alphabet.
+func NewCodec(alphabet *Alphabet) *Codec {
+	c := &Codec{alphabet: alphabet}
+	c.pool.New = func() interface{} { return new(codecScratch) }
+	return c
+}
+
+// Encode encodes the passed bytes into a base58 encoded string.
+func (c *Codec) Encode(bin []byte) string {
+	if s, ok := encodeUnrolled(bin, c.alphabet); ok {
+		return s
+	}
+	if len(bin) > largeEncodeThreshold {
+		return largeEncode(bin, c.alphabet)
+	}
+	s := c.pool.Get().(*codecScratch)
+	out := string(c.encode(s, bin))
+	c.pool.Put(s)
+	return out
+}
+
+// AppendEncode appends the base58 encoding of bin to dst and returns the
+// extended buffer.
+func (c *Codec) AppendEncode(dst, bin []byte) []byte {
+	if out, ok := appendEncodeUnrolled(dst, bin, c.alphabet); ok {
+		return out
+	}
+	if len(bin) > largeEncodeThreshold {
+		return append(dst, largeEncode(bin, c.alphabet)...)
+	}
+	s := c.pool.Get().(*codecScratch)
+	dst = append(dst, c.encode(s, bin)...)
+	c.pool.Put(s)
+	return dst
+}
+
+// Decode decodes the base58 encoded string.
+func (c *Codec) Decode(str string) ([]byte, error) {
+	if unrolledDecodable(len(str), c.alphabet) {
+		return decodeUnrolled(str, c.alphabet)
+	}
+	if len(str) > largeDecodeThreshold {
+		return largeDecode(str, c.alphabet)
+	}
+	s := c.pool.Get().(*codecScratch)
+	defer c.pool.Put(s)
+	dec, err := c.decode(s, str)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(nil), dec...), nil
+}
+
+// AppendDecode appends the bytes decoded from str to dst and returns the
+// extended buffer. On error dst is returned unchanged.
+func (c *Codec) AppendDecode(dst []byte, str string) ([]byte, error) {
+	if unrolledDecodable(len(str), c.alphabet) {
+		return appendDecodeUnrolled(dst, str, c.alphabet)
+	}
+	if len(str) > largeDecodeThreshold {
+		dec, err := largeDecode(str, c.alphabet)
+		if err != nil {
+			return dst, err
+		}
+		return append(dst, dec...), nil
+	}
+	s := c.pool.Get().(*codecScratch)
+	defer c.pool.Put(s)
+	dec, err := c.decode(s, str)
+	if err != nil {
+		return dst, err
+	}
+	return append(dst, dec...), nil
+}
+
+// encode returns the encoding of bin, held in the scratch buffer.
+func (c *Codec) encode(s *codecScratch, bin []byte) []byte {
+	out := s.bytes(len(bin)*555/406 + 1)
+	for i := range out {
+		out[i] = 0
+	}
+	return fastEncodeTo(out, bin, c.alphabet)
+}
+
+// decode returns the decoding of str, held in the scratch buffer.
+func (c *Codec) decode(s *codecScratch, str string) ([]byte, error) {
+	binu := s.bytes(2 * ((len(str) * 406 / 555) + 1))
+	n := (len(str) + 3) / 4
+	if cap(s.limbs) < n {
+		s.limbs = make([]uint32, n)
+	}
+	outi := s.limbs[:n]
+	for i := range outi {
+		outi[i] = 0
+	}
+	return fastDecodeTo(binu, outi, str, c.alphabet)
+}
+
+func (s *codecScratch) bytes(n int) []byte {
+	if cap(s.buf) < n {
+		s.buf = make([]byte, n)
+	}
+	return s.buf[:n]
+}
@@ -0,0 +1,148 @@
+package base58
+
+import (
+	"bytes"
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+// codecTestPairs returns n random 32 byte values and their encodings.
+func codecTestPairs(n int) []testValues {
+	pairs := make([]testValues, n)
+	for i := range pairs {
+		data := make([]byte, 32)
+		rand.Read(data)
+		pairs[i] = testValues{dec: data, enc: EncodeAlphabet(data, BTCAlphabet)}
+	}
+	return pairs
+}
+
+func TestCodecEqPackage(t *testing.T) {
+	for _, alph := range []*Alphabet{BTCAlphabet, FlickrAlphabet, randAlphabet()} {
+		c := NewCodec(alph)
+		// Shrinking and growing inputs make sure stale scratch contents do
+		// not leak into results.
+		for _, n := range []int{64, 1, 32, 0, 200, 3, 25} {
+			b := make([]byte, n)
+			rand.Read(b)
+			if n > 2 {
+				b[0] = 0
+			}
+
+			enc := c.Encode(b)
+			if want := EncodeAlphabet(b, alph); enc != want {
+				t.Fatalf("len %d: Codec.Encode = %s, want %s", n, enc, want)
+			}
+			if app := c.AppendEncode([]byte("x"), b); string(app) != "x"+enc {
+				t.Fatalf("len %d: AppendEncode = %s", n, app)
+			}
+			if n == 0 {
+				continue
+			}
+
+			dec, err := c.Decode(enc)
+			if err != nil || !bytes.Equal(dec, b) {
+				t.Fatalf("len %d: Codec.Decode = %x, %v", n, dec, err)
+			}
+			app, err := c.AppendDecode([]byte{0xff}, enc)
+			if err != nil || !bytes.Equal(app, append([]byte{0xff}, b...)) {
+				t.Fatalf("len %d: AppendDecode = %x, %v", n, app, err)
+			}
+		}
+	}
+}
+
+func TestCodecErrors(t *testing.T) {
+	c := NewCodec(BTCAlphabet)
+	for _, s := range []string{"", "0", "abc\xff"} {
+		_, want := Decode(s)
+		if _, err := c.Decode(s); err == nil || err.Error() != want.Error() {
+			t.Errorf("Decode(%q) error %v, want %v", s, err, want)
+		}
+		dst := []byte("keep")
+		if got, err := c.AppendDecode(dst, s); err == nil || string(got) != "keep" {
+			t.Errorf("AppendDecode(%q) = %q, %v", s, got, err)
+		}
+	}
+}
+
+func TestCodecConcurrent(t *testing.T) {
+	pairs := codecTestPairs(2000)
+	c := NewCodec(BTCAlphabet)
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := g; i < 2000; i += 8 {
+				p := pairs[i]
+				if c.Encode(p.dec) != p.enc {
+					t.Errorf("concurrent encode mismatch at %d", i)
+				}
+				if dec, err := c.Decode(p.enc); err != nil || !bytes.Equal(dec, p.dec) {
+					t.Errorf("concurrent decode mismatch at %d", i)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+}
+
+func BenchmarkCodecEncode(b *testing.B) {
+	pairs := codecTestPairs(1000)
+	c := NewCodec(BTCAlphabet)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c.Encode(pairs[i%len(pairs)].dec)
+	}
+}
+
+func BenchmarkCodecAppendEncode(b *testing.B) {
+	pairs := codecTestPairs(1000)
+	c := NewCodec(BTCAlphabet)
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = c.AppendEncode(buf[:0], pairs[i%len(pairs)].dec)
+	}
+}
+
+func BenchmarkCodecDecode(b *testing.B) {
+	pairs := codecTestPairs(1000)
+	c := NewCodec(BTCAlphabet)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c.Decode(pairs[i%len(pairs)].enc)
+	}
+}
+
+func BenchmarkCodecAppendDecode(b *testing.B) {
+	pairs := codecTestPairs(1000)
+	c := NewCodec(BTCAlphabet)
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf, _ = c.AppendDecode(buf[:0], pairs[i%len(pairs)].enc)
+	}
+}
+
+func TestCodecAppendAllocs(t *testing.T) {
+	c := NewCodec(BTCAlphabet)
+	for _, n := range []int{20, 32, 64} {
+		b := make([]byte, n)
+		rand.Read(b)
+		enc := c.Encode(b)
+		buf := make([]byte, 0, 128)
+		if allocs := testing.AllocsPerRun(100, func() { c.AppendEncode(buf[:0], b) }); allocs != 0 {
+			t.Errorf("len %d: AppendEncode made %v allocations", n, allocs)
+		}
+		if allocs := testing.AllocsPerRun(100, func() { c.AppendDecode(buf[:0], enc) }); allocs != 0 {
+			t.Errorf("len %d: AppendDecode made %v allocations", n, allocs)
+		}
+	}
+}
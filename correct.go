@@ -0,0 +1,175 @@
+package base58
+
+import (
+	"errors"
+	"sort"
+)
+
+// ErrSearchLimit is returned by Correct when the search space exceeds the
+// work limit before all candidates within the edit distance were checked.
+// The corrections found so far are returned along with it.
+var ErrSearchLimit = errors.New("correction search limit reached")
+
+// correctMaxChecks bounds the number of candidate strings Correct examines,
+// which keeps the worst case to a couple of seconds. A single edit of an
+// address-sized input needs about four thousand checks; two edits need
+// millions, so such searches may end with ErrSearchLimit after covering
+// the likeliest mistakes.
+const correctMaxChecks = 1 << 20
+
+// confusables lists characters commonly typed in place of base58 digits.
+// Most are characters that the usual alphabets leave out on purpose.
+var confusables = map[byte]string{
+	'0': "oO",
+	'O': "o0",
+	'o': "O",
+	'I': "1lij",
+	'l': "1IiL",
+	'1': "lI",
+	'i': "1lI",
+}
+
+// Correction is a valid Base58Check string close to a mistyped one.
+type Correction struct {
+	// Value is the corrected string.
+	Value string
+	// Edits is the number of substitutions, adjacent transpositions,
+	// insertions and deletions separating Value from the input.
+	Edits int
+}
+
+// Correct searches for Base58Check strings within maxEdits edits of s, for
+// when a hand-copied string fails its checksum. It returns the candidates
+// with the fewest edits, so an input that is already valid is returned as
+// is.
+func Correct(s string, maxEdits int) ([]Correction, error) {
+	return CorrectAlphabet(s, maxEdits, BTCAlphabet)
+}
+
+// CorrectAlphabet is like Correct but uses the given b58 alphabet.
+func CorrectAlphabet(s string, maxEdits int, alphabet *Alphabet) ([]Correction, error) {
+	c := corrector{alphabet: alphabet, found: make(map[string]int)}
+
+	frontier := []string{s}
+	seen := map[string]bool{s: true}
+	c.check(s)
+
+	for c.edits = 1; c.edits <= maxEdits && len(c.found) == 0 && !c.exhausted; c.edits++ {
+		last := c.edits == maxEdits
+		var next []string
+		for _, f := range frontier {
+			c.neighbours(f, func(n string) bool {
+				if seen[n] {
+					return true
+				}
+				// The last level is never expanded, so it need not be
+				// remembered either.
+				if !last {
+					seen[n] = true
+					next = append(next, n)
+				}
+				c.check(n)
+				return !c.exhausted
+			})
+			if c.exhausted {
+				break
+			}
+		}
+		frontier = next
+	}
+
+	out := make([]Correction, 0, len(c.found))
+	for v, edits := range c.found {
+		out = append(out, Correction{Value: v, Edits: edits})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Value < out[j].Value })
+
+	if c.exhausted {
+		return out, ErrSearchLimit
+	}
+	return out, nil
+}
+
+type corrector struct {
+	alphabet  *Alphabet
+	found     map[string]int
+	edits     int
+	checks    int
+	exhausted bool
+}
+
+func (c *corrector) valid(b byte) bool {
+	return b < 128 && c.alphabet.decode[b] != -1
+}
+
+// check records n as a correction if it is a valid Base58Check string.
+func (c *corrector) check(n string) {
+	c.checks++
+	if c.checks > correctMaxChecks {
+		c.exhausted = true
+		return
+	}
+	for i := 0; i < len(n); i++ {
+		if !c.valid(n[i]) {
+			return
+		}
+	}
+	if _, err := CheckDecodeAlphabet(n, c.alphabet); err == nil {
+		c.found[n] = c.edits
+	}
+}
+
+// neighbours calls fn with every string one edit away from s, trying the
+// likeliest mistakes first, until fn returns false.
+func (c *corrector) neighbours(s string, fn func(string) bool) {
+	b := []byte(s)
+	enc := c.alphabet.encode[:]
+
+	// Confusable characters.
+	for i := range b {
+		for _, r := range []byte(confusables[b[i]]) {
+			if c.valid(r) && !fn(replaceAt(b, i, r)) {
+				return
+			}
+		}
+	}
+
+	// Adjacent transpositions.
+	for i := 0; i+1 < len(b); i++ {
+		if b[i] == b[i+1] {
+			continue
+		}
+		t := append([]byte(nil), b...)
+		t[i], t[i+1] = t[i+1], t[i]
+		if !fn(string(t)) {
+			return
+		}
+	}
+
+	// Substitutions, deletions and insertions.
+	for i := range b {
+		for _, r := range enc {
+			if r != b[i] && !fn(replaceAt(b, i, r)) {
+				return
+			}
+		}
+	}
+	for i := range b {
+		if !fn(s[:i] + s[i+1:]) {
+			return
+		}
+	}
+	for i := 0; i <= len(b); i++ {
+		for _, r := range enc {
+			if !fn(s[:i] + string(r) + s[i:]) {
+				return
+			}
+		}
+	}
+}
+
+func replaceAt(b []byte, i int, r byte) string {
+	t := append([]byte(nil), b...)
+	t[i] = r
+	return string(t)
+}
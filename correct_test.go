@@ -0,0 +1,51 @@
+package base58
+
+import (
+	"testing"
+	"time"
+)
+
+const correctAddr = "1QCaxc8hutpdZ62iKZsn1TCG3nh7uPZojq"
+
+func TestCorrectSingleEdits(t *testing.T) {
+	tests := []string{
+		"1QCaxc8hutpdZ62iKZsn1TCG3nh7uPZ0jq",  // confusable 0 for o
+		"1QCaxc8hutpdZ62iKZsn1TCG3nh7uPZjoq",  // transposition
+		"1QCaxc8hutpdZ62iKZsn1TCG3nh7uPZojr",  // substitution
+		"1QCaxc8hutpdZ62iKZsn1TCG3nh7uPZoq",   // deletion
+		"1QCaxc8hutpdZ62iKZsn1TCG3nh7uPZojqq", // insertion
+	}
+	for _, in := range tests {
+		cs, err := Correct(in, 1)
+		if err != nil {
+			t.Fatalf("Correct(%s): %v", in, err)
+		}
+		if len(cs) != 1 || cs[0].Value != correctAddr || cs[0].Edits != 1 {
+			t.Errorf("Correct(%s) = %v", in, cs)
+		}
+	}
+}
+
+func TestCorrectValidInput(t *testing.T) {
+	cs, err := Correct(correctAddr, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cs) != 1 || cs[0].Value != correctAddr || cs[0].Edits != 0 {
+		t.Errorf("Correct(valid) = %v", cs)
+	}
+}
+
+func TestCorrectBounded(t *testing.T) {
+	if testing.Short() {
+		t.Skip("exhausts the search limit")
+	}
+	start := time.Now()
+	_, err := Correct("1111111111111111111111111111111111", 3)
+	if err != ErrSearchLimit {
+		t.Errorf("three edits: got %v, want ErrSearchLimit", err)
+	}
+	if d := time.Since(start); d > 30*time.Second {
+		t.Errorf("bounded search took %v", d)
+	}
+}
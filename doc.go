@@ -17,5 +17,22 @@ With custom alphabet
   customAlphabet := base58.NewAlphabet("123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz")
   encoded := base58.EncodeAlphabet(buf, customAlphabet)
 
+Inputs of more than a few hundred bytes are converted with a subquadratic
+algorithm, so encoding and decoding stay practical for megabytes of data.
+NewEncoder and NewDecoder stream data as a sequence of base58 lines.
+Armor and Dearmor wrap data in PEM-like blocks with a checksum.
+EncodeUint64 and EncodeBigInt encode integers as plain base58 numbers.
+EncodeFixed pads encodings to a fixed width so that they sort in order.
+
+Other Formats
+
+CheckEncode and CheckDecode add and verify Base58Check checksums.
+DetectNetwork and ConvertAddress work with the address version bytes of
+registered coin networks.
+ValidMiniKey and MiniKeyToWIF handle Casascius mini private keys.
+SS58Encode and SS58Decode handle Polkadot and other Substrate addresses.
+ByronEncode and ByronDecode handle Cardano Byron-era addresses.
+ParseEOSKey and EOSKey.String handle Antelope (EOSIO) keys and signatures.
+
 */
 package base58
@@ -0,0 +1,147 @@
+package base58
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/mr-tron/base58/internal/ripemd160"
+)
+
+// Kinds of Antelope key strings.
+const (
+	EOSPublicKey  = "PUB"
+	EOSPrivateKey = "PVT"
+	EOSSignature  = "SIG"
+)
+
+// Antelope key curves.
+const (
+	EOSCurveK1 = "K1"
+	EOSCurveR1 = "R1"
+	EOSCurveWA = "WA"
+)
+
+// ErrEOSFormat is returned for strings that are not Antelope keys or
+// signatures, and for key data of the wrong length.
+var ErrEOSFormat = errors.New("malformed eos key string")
+
+const (
+	eosLegacyPrefix = "EOS"
+	eosChecksumSize = 4
+)
+
+// EOSKey is a decoded Antelope (EOSIO) public key, private key or signature.
+// Both string formats carry a 4 byte RIPEMD-160 checksum. Legacy public keys
+// have the form
+//
+//	"EOS" + base58(key || ripemd160(key)[:4])
+//
+// while the current format names the kind and curve and mixes the curve into
+// the checksum:
+//
+//	"PUB_K1_" + base58(key || ripemd160(key || "K1")[:4])
+type EOSKey struct {
+	// Kind is one of EOSPublicKey, EOSPrivateKey or EOSSignature.
+	Kind string
+	// Curve is one of EOSCurveK1, EOSCurveR1 or EOSCurveWA.
+	Curve string
+	// Data is the raw key or signature.
+	Data []byte
+	// Legacy marks public keys in the "EOS..." format. Only K1 public keys
+	// can be represented that way.
+	Legacy bool
+}
+
+// ParseEOSKey parses an Antelope key or signature string in either the legacy
+// or the current format, and verifies its checksum.
+func ParseEOSKey(s string) (*EOSKey, error) {
+	k := new(EOSKey)
+	var body string
+	if strings.HasPrefix(s, eosLegacyPrefix) {
+		k.Kind, k.Curve, k.Legacy = EOSPublicKey, EOSCurveK1, true
+		body = s[len(eosLegacyPrefix):]
+	} else {
+		parts := strings.SplitN(s, "_", 3)
+		if len(parts) != 3 {
+			return nil, ErrEOSFormat
+		}
+		k.Kind, k.Curve, body = parts[0], parts[1], parts[2]
+	}
+	if body == "" || !eosValidKind(k.Kind) || !eosValidCurve(k.Curve) {
+		return nil, ErrEOSFormat
+	}
+
+	raw, err := Decode(body)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) <= eosChecksumSize {
+		return nil, ErrEOSFormat
+	}
+	k.Data = raw[:len(raw)-eosChecksumSize]
+	if !eosValidLength(k.Kind, k.Curve, len(k.Data)) {
+		return nil, ErrEOSFormat
+	}
+
+	sum := k.checksum()
+	for i, b := range raw[len(k.Data):] {
+		if sum[i] != b {
+			return nil, ErrChecksum
+		}
+	}
+
+	return k, nil
+}
+
+// String formats the key in the format recorded by its Legacy field. It
+// panics if Legacy is set on anything but a K1 public key.
+func (k *EOSKey) String() string {
+	if k.Legacy && (k.Kind != EOSPublicKey || k.Curve != EOSCurveK1) {
+		panic("only K1 public keys have a legacy eos format")
+	}
+
+	sum := k.checksum()
+	buf := make([]byte, 0, len(k.Data)+eosChecksumSize)
+	buf = append(buf, k.Data...)
+	buf = append(buf, sum[:eosChecksumSize]...)
+
+	if k.Legacy {
+		return eosLegacyPrefix + Encode(buf)
+	}
+	return k.Kind + "_" + k.Curve + "_" + Encode(buf)
+}
+
+func (k *EOSKey) checksum() [ripemd160.Size]byte {
+	h := ripemd160.New()
+	h.Write(k.Data)
+	if !k.Legacy {
+		h.Write([]byte(k.Curve))
+	}
+	var sum [ripemd160.Size]byte
+	h.Sum(sum[:0])
+	return sum
+}
+
+func eosValidKind(kind string) bool {
+	return kind == EOSPublicKey || kind == EOSPrivateKey || kind == EOSSignature
+}
+
+func eosValidCurve(curve string) bool {
+	return curve == EOSCurveK1 || curve == EOSCurveR1 || curve == EOSCurveWA
+}
+
+// eosValidLength checks the data length for the fixed size key types.
+// WebAuthn keys and signatures embed variable length metadata.
+func eosValidLength(kind, curve string, n int) bool {
+	if curve == EOSCurveWA {
+		return true
+	}
+	switch kind {
+	case EOSPublicKey:
+		return n == 33
+	case EOSPrivateKey:
+		return n == 32
+	default:
+		return n == 65
+	}
+}
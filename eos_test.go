@@ -0,0 +1,70 @@
+package base58
+
+import (
+	"bytes"
+	"testing"
+)
+
+// The well-known eosio development key in both formats.
+const (
+	eosLegacyKey = "EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV"
+	eosNewKey    = "PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63"
+)
+
+func TestEOSLegacyToNew(t *testing.T) {
+	k, err := ParseEOSKey(eosLegacyKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !k.Legacy || k.Kind != EOSPublicKey || k.Curve != EOSCurveK1 || len(k.Data) != 33 {
+		t.Fatalf("unexpected key %+v", k)
+	}
+	if s := k.String(); s != eosLegacyKey {
+		t.Errorf("String() = %s, want %s", s, eosLegacyKey)
+	}
+
+	k.Legacy = false
+	if s := k.String(); s != eosNewKey {
+		t.Errorf("String() = %s, want %s", s, eosNewKey)
+	}
+
+	n, err := ParseEOSKey(eosNewKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.Legacy || !bytes.Equal(n.Data, k.Data) {
+		t.Errorf("new format key differs: %+v", n)
+	}
+}
+
+func TestEOSSignatureRoundTrip(t *testing.T) {
+	sig := &EOSKey{Kind: EOSSignature, Curve: EOSCurveK1, Data: bytes.Repeat([]byte{0x1f}, 65)}
+	s := sig.String()
+	if s[:7] != "SIG_K1_" {
+		t.Fatalf("unexpected prefix in %s", s)
+	}
+	got, err := ParseEOSKey(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Kind != EOSSignature || !bytes.Equal(got.Data, sig.Data) {
+		t.Errorf("round trip mismatch: %+v", got)
+	}
+}
+
+func TestEOSErrors(t *testing.T) {
+	// The new format checksum covers the curve name, so relabelling a key
+	// must fail the checksum rather than silently succeed.
+	if _, err := ParseEOSKey("PUB_R1_" + eosNewKey[7:]); err != ErrChecksum {
+		t.Errorf("relabelled curve: got %v", err)
+	}
+	// A legacy body under the new prefix uses the wrong checksum scheme.
+	if _, err := ParseEOSKey("PUB_K1_" + eosLegacyKey[3:]); err != ErrChecksum {
+		t.Errorf("legacy body: got %v", err)
+	}
+	for _, s := range []string{"", "EOS", "PUB_K1", "XYZ_K1_" + eosNewKey[7:], "PUB_X1_" + eosNewKey[7:]} {
+		if _, err := ParseEOSKey(s); err != ErrEOSFormat {
+			t.Errorf("ParseEOSKey(%q): got %v", s, err)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package base58
+
+import (
+	"errors"
+	"strconv"
+)
+
+// ErrChecksum is returned when the checksum embedded in an encoded string
+// does not match the data it protects.
+var ErrChecksum = errors.New("checksum mismatch")
+
+// CorruptInputError is returned by the lenient decoders for a character that
+// is neither a digit, whitespace nor a separator. Its value is the byte
+// offset of that character in the original input.
+type CorruptInputError int64
+
+func (e CorruptInputError) Error() string {
+	return "illegal base58 data at input byte " + strconv.FormatInt(int64(e), 10)
+}
+
+// ErrOverflow is returned when a value does not fit the requested integer
+// type or width.
+var ErrOverflow = errors.New("value out of range")
+
+// ErrNegative is returned when asked to encode a negative number.
+var ErrNegative = errors.New("cannot encode a negative number")
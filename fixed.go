@@ -0,0 +1,67 @@
+package base58
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// FixedWidth returns the number of characters needed to encode any value of
+// size bytes.
+func FixedWidth(size int) int {
+	return int(math.Ceil(float64(size) * 8 / math.Log2(58)))
+}
+
+// EncodeFixed encodes src as a big-endian number padded on the left with
+// the zero digit to width characters. With an order-preserving alphabet such
+// as BTCAlphabet the encodings sort in numeric order, which suits database
+// keys. It returns ErrOverflow if the value needs more than width characters.
+func EncodeFixed(src []byte, width int) (string, error) {
+	return EncodeFixedAlphabet(src, width, BTCAlphabet)
+}
+
+// EncodeFixedAlphabet is like EncodeFixed but uses the passed alphabet.
+func EncodeFixedAlphabet(src []byte, width int, alphabet *Alphabet) (string, error) {
+	for len(src) > 0 && src[0] == 0 {
+		src = src[1:]
+	}
+	var s string
+	if len(src) > 0 {
+		s = FastBase58EncodingAlphabet(src, alphabet)
+	}
+	if len(s) > width {
+		return "", ErrOverflow
+	}
+	return strings.Repeat(string(alphabet.encode[0]), width-len(s)) + s, nil
+}
+
+// DecodeFixed decodes a number encoded by EncodeFixed, of any width, into
+// exactly size bytes. It returns ErrOverflow if the value does not fit, and
+// an error if size is negative.
+func DecodeFixed(str string, size int) ([]byte, error) {
+	return DecodeFixedAlphabet(str, size, BTCAlphabet)
+}
+
+// DecodeFixedAlphabet is like DecodeFixed but uses the passed alphabet.
+func DecodeFixedAlphabet(str string, size int, alphabet *Alphabet) ([]byte, error) {
+	if len(str) == 0 {
+		return nil, fmt.Errorf("zero length string")
+	}
+	if size < 0 {
+		return nil, fmt.Errorf("negative size %d", size)
+	}
+	out := make([]byte, size)
+	digits := strings.TrimLeft(str, string(alphabet.encode[0]))
+	if digits == "" {
+		return out, nil
+	}
+	b, err := FastBase58DecodingAlphabet(digits, alphabet)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) > size {
+		return nil, ErrOverflow
+	}
+	copy(out[size-len(b):], b)
+	return out, nil
+}
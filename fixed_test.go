@@ -0,0 +1,100 @@
+package base58
+
+import (
+	"bytes"
+	"math/big"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestFixedWidth(t *testing.T) {
+	b58 := big.NewInt(58)
+	for size := 0; size <= 512; size++ {
+		w := FixedWidth(size)
+		values := new(big.Int).Lsh(big.NewInt(1), uint(8*size))
+		// 58^w must hold every size-byte value and 58^(w-1) must not.
+		if new(big.Int).Exp(b58, big.NewInt(int64(w)), nil).Cmp(values) < 0 ||
+			w > 0 && new(big.Int).Exp(b58, big.NewInt(int64(w-1)), nil).Cmp(values) >= 0 {
+			t.Fatalf("FixedWidth(%d) = %d", size, w)
+		}
+	}
+	if got := FixedWidth(16); got != 22 {
+		t.Errorf("FixedWidth(16) = %d, want 22", got)
+	}
+}
+
+func TestEncodeFixed(t *testing.T) {
+	tests := []struct {
+		src   []byte
+		width int
+		want  string
+	}{
+		{[]byte{}, 3, "111"},
+		{[]byte{0, 0}, 3, "111"},
+		{[]byte{57}, 3, "11z"},
+		{[]byte{0, 58}, 3, "121"},
+		{[]byte{0xff, 0xff}, 3, "LUv"},
+		{[]byte("Hello World!"), 20, "1112NEpo7TZRRrLZSi2U"},
+	}
+	for _, tt := range tests {
+		got, err := EncodeFixed(tt.src, tt.width)
+		if got != tt.want || err != nil {
+			t.Errorf("EncodeFixed(%x, %d) = %q, %v, want %q", tt.src, tt.width, got, err, tt.want)
+		}
+		back, err := DecodeFixed(got, len(tt.src))
+		if err != nil || !bytes.Equal(back, tt.src) {
+			t.Errorf("DecodeFixed(%q, %d) = %x, %v", got, len(tt.src), back, err)
+		}
+	}
+
+	if _, err := EncodeFixed([]byte{0xff, 0xff}, 2); err != ErrOverflow {
+		t.Errorf("got %v, want ErrOverflow", err)
+	}
+	if _, err := DecodeFixed("LUv", 1); err != ErrOverflow {
+		t.Errorf("got %v, want ErrOverflow", err)
+	}
+	if _, err := DecodeFixed("1O", 4); err == nil || err == ErrOverflow {
+		t.Errorf("got %v, want an invalid digit error", err)
+	}
+	if _, err := DecodeFixed("", 4); err == nil {
+		t.Error("expected an error for an empty string")
+	}
+	if _, err := DecodeFixed("LUv", -1); err == nil {
+		t.Error("expected an error for a negative size")
+	}
+	if _, err := EncodeFixed(nil, -1); err != ErrOverflow {
+		t.Errorf("negative width: got %v, want ErrOverflow", err)
+	}
+}
+
+func TestEncodeFixedSorts(t *testing.T) {
+	const size = 16
+	width := FixedWidth(size)
+	srcs := make([][]byte, 1000)
+	encs := make([]string, len(srcs))
+	for i := range srcs {
+		srcs[i] = make([]byte, size)
+		rand.Read(srcs[i][rand.Intn(size):])
+		var err error
+		if encs[i], err = EncodeFixed(srcs[i], width); err != nil || len(encs[i]) != width {
+			t.Fatalf("%x: got %q, %v", srcs[i], encs[i], err)
+		}
+	}
+	sort.Slice(srcs, func(i, j int) bool { return bytes.Compare(srcs[i], srcs[j]) < 0 })
+	sort.Strings(encs)
+	for i := range srcs {
+		if got, _ := DecodeFixed(encs[i], size); !bytes.Equal(got, srcs[i]) {
+			t.Fatalf("sorted position %d: got %x, want %x", i, got, srcs[i])
+		}
+	}
+}
+
+func TestIsOrderPreserving(t *testing.T) {
+	if !BTCAlphabet.IsOrderPreserving() {
+		t.Error("BTCAlphabet should be order preserving")
+	}
+	if FlickrAlphabet.IsOrderPreserving() || RippleAlphabet.IsOrderPreserving() {
+		t.Error("FlickrAlphabet and RippleAlphabet should not be order preserving")
+	}
+}
@@ -0,0 +1,367 @@
+//go:build ignore
+// +build ignore
+
+// This program generates unrolled_gen.go, which holds encoders and decoders
+// unrolled for fixed input sizes. Encode, Decode and their alphabet variants
+// dispatch to them when both the length and the alphabet match. Run it with
+// go generate; the flags select the byte lengths and the alphabets:
+//
+//	go run gen_unrolled.go -lengths 25,32,33,34,64,82 -alphabets BTCAlphabet,FlickrAlphabet
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// alphabets are the package's predefined alphabets by variable name.
+var alphabets = map[string]string{
+	"BTCAlphabet":    "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz",
+	"FlickrAlphabet": "123456789abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ",
+	"RippleAlphabet": "rpshnaf39wBUDNEGHJKLM4PQRST7VWXYZ2bcdeCg65jkm8oFqi1tuvAxyz",
+}
+
+// 58^5 is the largest power of the base that fits in a limb.
+const (
+	group     = 5
+	groupBase = 656356768
+)
+
+var log2of58 = math.Log2(58)
+
+func main() {
+	var (
+		lengthsFlag   = flag.String("lengths", "25,32,33,34,64,82", "comma separated input lengths in bytes")
+		alphabetsFlag = flag.String("alphabets", "BTCAlphabet,FlickrAlphabet", "comma separated alphabet variable names")
+		output        = flag.String("o", "unrolled_gen.go", "output file")
+	)
+	flag.Parse()
+
+	var lengths []int
+	for _, f := range strings.Split(*lengthsFlag, ",") {
+		n, err := strconv.Atoi(f)
+		if err != nil || n < 1 {
+			log.Fatalf("invalid length %q", f)
+		}
+		lengths = append(lengths, n)
+	}
+	sort.Ints(lengths)
+
+	var names []string
+	for _, name := range strings.Split(*alphabetsFlag, ",") {
+		if _, ok := alphabets[name]; !ok {
+			log.Fatalf("unknown alphabet %q", name)
+		}
+		names = append(names, name)
+	}
+
+	g := &generator{}
+	g.header(*lengthsFlag, *alphabetsFlag)
+	g.encodeDispatch(lengths, names)
+	for _, n := range lengths {
+		g.encodeDigits(n)
+		for _, name := range names {
+			g.encodeAlphabet(n, name)
+		}
+	}
+	g.decodeDispatch(lengths, names)
+
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		log.Fatalf("formatting generated code: %v\n%s", err, g.buf.Bytes())
+	}
+	if err := ioutil.WriteFile(*output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type generator struct {
+	buf bytes.Buffer
+}
+
+func (g *generator) p(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+	g.buf.WriteByte('\n')
+}
+
+// maxDigits is the longest encoding of n bytes, as estimated by the generic
+// encoder, rounded up to whole digit groups.
+func maxDigits(n int) int {
+	d := n*555/406 + 1
+	return (d + group - 1) / group * group
+}
+
+// limbs is the number of 32 bit limbs holding any value of d digits.
+func limbs(d int) int {
+	return int(math.Ceil(float64(d) * log2of58 / 32))
+}
+
+func suffix(name string) string {
+	return strings.TrimSuffix(name, "Alphabet")
+}
+
+func (g *generator) header(lengths, alphabets string) {
+	g.p("// Code generated by go run gen_unrolled.go -lengths %s -alphabets %s; DO NOT EDIT.", lengths, alphabets)
+	g.p("")
+	g.p("package base58")
+	g.p("")
+}
+
+func (g *generator) encodeDispatch(lengths []int, names []string) {
+	g.p("// encodeUnrolled encodes bin with an unrolled encoder if one was generated")
+	g.p("// for its length and alphabet.")
+	g.p("func encodeUnrolled(bin []byte, alphabet *Alphabet) (string, bool) {")
+	g.p("switch alphabet {")
+	for _, name := range names {
+		g.p("case %s:", name)
+		g.p("switch len(bin) {")
+		for _, n := range lengths {
+			g.p("case %d:", n)
+			g.p("return encodeUnrolled%d%s(bin), true", n, suffix(name))
+		}
+		g.p("}")
+	}
+	g.p("}")
+	g.p(`return "", false`)
+	g.p("}")
+	g.p("")
+
+	g.p("// appendEncodeUnrolled is like encodeUnrolled but appends the encoding to")
+	g.p("// dst.")
+	g.p("func appendEncodeUnrolled(dst, bin []byte, alphabet *Alphabet) ([]byte, bool) {")
+	var conds []string
+	for _, name := range names {
+		conds = append(conds, "alphabet != "+name)
+	}
+	g.p("if %s {", strings.Join(conds, " && "))
+	g.p("return dst, false")
+	g.p("}")
+	g.p("var digits []byte")
+	g.p("switch len(bin) {")
+	for _, n := range lengths {
+		g.p("case %d:", n)
+		g.p("var buf [%d]byte", maxDigits(n))
+		g.p("digits = buf[encodeDigits%d(bin, &buf):]", n)
+	}
+	g.p("default:")
+	g.p("return dst, false")
+	g.p("}")
+	g.p("start := len(dst)")
+	g.p("dst = append(dst, digits...)")
+	g.p("for j := start; j < len(dst); j++ {")
+	g.p("dst[j] = alphabet.encode[dst[j]]")
+	g.p("}")
+	g.p("return dst, true")
+	g.p("}")
+	g.p("")
+}
+
+// encodeDigits emits the alphabet independent part of the encoder: the input
+// is loaded into limbs which are repeatedly divided by 58^5. Limbs that are
+// known to have become zero are left out of later divisions.
+func (g *generator) encodeDigits(n int) {
+	k := (n + 3) / 4
+	d := maxDigits(n)
+
+	g.p("// encodeDigits%d writes the base58 digit values of the %d byte src to the", n, n)
+	g.p("// end of buf and returns the start of the encoding, which includes one zero")
+	g.p("// digit per leading zero byte.")
+	g.p("func encodeDigits%d(src []byte, buf *[%d]byte) int {", n, d)
+	g.p("_ = src[%d]", n-1)
+
+	// Load the limbs, most significant first; the first may be partial.
+	first := n - (k-1)*4
+	for i := 0; i < k; i++ {
+		start := 0
+		size := 4
+		if i == 0 {
+			size = first
+		} else {
+			start = first + (i-1)*4
+		}
+		var parts []string
+		for j := 0; j < size; j++ {
+			shift := 8 * (size - 1 - j)
+			if shift == 0 {
+				parts = append(parts, fmt.Sprintf("uint32(src[%d])", start+j))
+			} else {
+				parts = append(parts, fmt.Sprintf("uint32(src[%d])<<%d", start+j, shift))
+			}
+		}
+		g.p("x%d := %s", i, strings.Join(parts, " | "))
+	}
+	g.p("var r, t uint64")
+	g.p("")
+
+	for grp := 0; grp < d/group; grp++ {
+		// Bits left in the quotient after grp divisions by 58^5.
+		bits := 8*n - int(math.Floor(float64(grp*group)*log2of58))
+		active := k
+		if bits <= 0 {
+			active = 1
+		} else if a := (bits + 31) / 32; a < k {
+			active = a
+		}
+		top := k - active
+
+		g.p("// digits %d to %d", grp*group, grp*group+group-1)
+		g.p("r = 0")
+		for i := top; i < k; i++ {
+			g.p("t = r<<32 | uint64(x%d)", i)
+			g.p("x%d = uint32(t / %d)", i, groupBase)
+			g.p("r = t - uint64(x%d)*%d", i, groupBase)
+		}
+		for j := 0; j < group; j++ {
+			pos := d - 1 - grp*group - j
+			if j < group-1 {
+				g.p("buf[%d] = byte(r %% 58)", pos)
+				g.p("r /= 58")
+			} else {
+				g.p("buf[%d] = byte(r)", pos)
+			}
+		}
+		g.p("")
+	}
+	g.p("i := 0")
+	g.p("for i < len(buf) && buf[i] == 0 {")
+	g.p("i++")
+	g.p("}")
+	g.p("for z := 0; z < %d && src[z] == 0; z++ {", n)
+	g.p("i--")
+	g.p("}")
+	g.p("return i")
+	g.p("}")
+	g.p("")
+}
+
+func (g *generator) encodeAlphabet(n int, name string) {
+	d := maxDigits(n)
+	g.p("func encodeUnrolled%d%s(src []byte) string {", n, suffix(name))
+	g.p("const chars = %q", alphabets[name])
+	g.p("var buf [%d]byte", d)
+	g.p("i := encodeDigits%d(src, &buf)", n)
+	g.p("for j := i; j < len(buf); j++ {")
+	g.p("buf[j] = chars[buf[j]]")
+	g.p("}")
+	g.p("return string(buf[i:])")
+	g.p("}")
+	g.p("")
+}
+
+// decodeDispatch emits one decoder per limb count, each accepting strings up
+// to the longest encoding of the corresponding configured length. The loop
+// over the input stays, but each pass over the limbs is unrolled.
+func (g *generator) decodeDispatch(lengths []int, names []string) {
+	type decoder struct{ maxLen, limbs int }
+	var decs []decoder
+	for _, n := range lengths {
+		d := n*555/406 + 1
+		// Strings of all leading zeros are as long as the input.
+		if d < n {
+			d = n
+		}
+		k := limbs(d)
+		if len(decs) > 0 && decs[len(decs)-1].limbs == k {
+			decs[len(decs)-1].maxLen = d
+			continue
+		}
+		decs = append(decs, decoder{d, k})
+	}
+	maxLen := decs[len(decs)-1].maxLen
+	maxLimbs := decs[len(decs)-1].limbs
+
+	g.p("const (")
+	g.p("// maxUnrolledDecode is the longest string decodeUnrolled accepts.")
+	g.p("maxUnrolledDecode = %d", maxLen)
+	g.p("// maxUnrolledLimbs is the number of limbs of the largest decoder.")
+	g.p("maxUnrolledLimbs = %d", maxLimbs)
+	g.p(")")
+	g.p("")
+	g.p("// unrolledDecodable reports whether decodeUnrolled handles strings of")
+	g.p("// length n in the alphabet.")
+	g.p("func unrolledDecodable(n int, alphabet *Alphabet) bool {")
+	var conds []string
+	for _, name := range names {
+		conds = append(conds, "alphabet == "+name)
+	}
+	g.p("return n > 0 && n <= maxUnrolledDecode && (%s)", strings.Join(conds, " || "))
+	g.p("}")
+	g.p("")
+
+	g.p("// appendDecodeUnrolled appends the decoding of str, which must satisfy")
+	g.p("// unrolledDecodable, to dst. On error dst is returned unchanged.")
+	g.p("func appendDecodeUnrolled(dst []byte, str string, alphabet *Alphabet) ([]byte, error) {")
+	g.p("var buf [maxUnrolledDecode]byte")
+	g.p("digits := buf[:len(str)]")
+	g.p("if n := translate(digits, str, alphabet); n < len(str) {")
+	g.p("return dst, invalidDigitError(str, n)")
+	g.p("}")
+	g.p("zcount := 0")
+	g.p("for zcount < len(digits) && digits[zcount] == 0 {")
+	g.p("zcount++")
+	g.p("}")
+	g.p("")
+	g.p("var z [maxUnrolledLimbs]uint32")
+	g.p("switch {")
+	for i, dec := range decs {
+		if i == len(decs)-1 {
+			g.p("default:")
+		} else {
+			g.p("case len(str) <= %d:", dec.maxLen)
+		}
+		g.p("decodeLimbs%d(digits, &z)", dec.limbs)
+	}
+	g.p("}")
+	g.p("return appendUnrolledBytes(dst, z[:], zcount), nil")
+	g.p("}")
+	g.p("")
+
+	for _, dec := range decs {
+		g.decodeLimbs(dec.limbs, maxLimbs)
+	}
+}
+
+// decodeLimbs emits a decoder into k limbs, stored at the end of z. Each pass
+// multiplies the limbs by 58 to the power of up to five digits and adds them.
+func (g *generator) decodeLimbs(k, maxLimbs int) {
+	var xs []string
+	for i := 0; i < k; i++ {
+		xs = append(xs, fmt.Sprintf("x%d", i))
+	}
+
+	g.p("// decodeLimbs%d decodes the digit values into the last %d limbs of z.", k, k)
+	g.p("func decodeLimbs%d(digits []byte, z *[maxUnrolledLimbs]uint32) {", k)
+	g.p("var %s uint32", strings.Join(xs, ", "))
+	g.p("for i := 0; i < len(digits); i += %d {", group)
+	g.p("end := i + %d", group)
+	g.p("if end > len(digits) {")
+	g.p("end = len(digits)")
+	g.p("}")
+	g.p("m, c := uint64(1), uint64(0)")
+	g.p("for _, d := range digits[i:end] {")
+	g.p("m *= 58")
+	g.p("c = c*58 + uint64(d)")
+	g.p("}")
+	g.p("t := uint64(x%d)*m + c", k-1)
+	g.p("x%d = uint32(t)", k-1)
+	for i := k - 2; i >= 0; i-- {
+		g.p("t = uint64(x%d)*m + t>>32", i)
+		g.p("x%d = uint32(t)", i)
+	}
+	g.p("}")
+	var zs []string
+	for i := 0; i < k; i++ {
+		zs = append(zs, fmt.Sprintf("z[%d]", maxLimbs-k+i))
+	}
+	g.p("%s = %s", strings.Join(zs, ", "), strings.Join(xs, ", "))
+	g.p("}")
+	g.p("")
+}
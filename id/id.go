@@ -0,0 +1,183 @@
+// Package id generates unique identifiers that sort by creation time and
+// encode to short, fixed-width base58 strings.
+//
+// An ID is 16 bytes: a 48-bit big-endian Unix timestamp in milliseconds
+// followed by 80 random bits. Its string form is always 22 characters of
+// base58 in the bitcoin alphabet, which is in ASCII order, so IDs compare
+// the same as bytes and as strings. IDs made by one Generator within the
+// same millisecond increment the random bits of the previous ID, keeping
+// them strictly increasing.
+package id
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/mr-tron/base58"
+)
+
+const (
+	// Size is the length of an ID in bytes.
+	Size = 16
+
+	// EncodedLen is the length of the string form of an ID.
+	EncodedLen = 22
+
+	timeSize = 6
+	maxTime  = 1<<(8*timeSize) - 1
+)
+
+var (
+	// ErrInvalid is returned when parsing a string that is not an ID.
+	ErrInvalid = errors.New("invalid base58 id")
+
+	// ErrTimeRange is returned for times before 1970 or after the year
+	// 10889, which do not fit in an ID.
+	ErrTimeRange = errors.New("time out of id range")
+
+	// ErrOverflow is returned when the random bits of an ID run out
+	// within a millisecond.
+	ErrOverflow = errors.New("id overflow within one millisecond")
+)
+
+// ID is a time-sortable unique identifier.
+type ID [Size]byte
+
+// Nil is the zero ID.
+var Nil ID
+
+var defaultGenerator = NewGenerator(nil)
+
+// New returns a new ID for the current time from the default generator. It
+// panics if the system random number generator fails.
+func New() ID {
+	id, err := defaultGenerator.New()
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// Generator makes IDs that increase strictly, even within a millisecond or
+// when the clock steps back. It is safe for concurrent use.
+type Generator struct {
+	mu   sync.Mutex
+	rand io.Reader
+	last ID
+}
+
+// NewGenerator returns a generator reading random bits from r, or from
+// crypto/rand if r is nil.
+func NewGenerator(r io.Reader) *Generator {
+	if r == nil {
+		r = rand.Reader
+	}
+	return &Generator{rand: r}
+}
+
+// New returns a new ID for the current time.
+func (g *Generator) New() (ID, error) {
+	return g.NewAt(time.Now())
+}
+
+// NewAt returns a new ID for t. If t is not after the time of the previous
+// ID, the ID keeps that time and increments its random bits instead.
+func (g *Generator) NewAt(t time.Time) (ID, error) {
+	if t.Unix() < 0 || t.Unix() > maxTime/1000 {
+		return Nil, ErrTimeRange
+	}
+	ms := t.Unix()*1000 + int64(t.Nanosecond())/int64(time.Millisecond)
+	if ms > maxTime {
+		return Nil, ErrTimeRange
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	var id ID
+	if g.last != Nil && uint64(ms) <= g.last.timestamp() {
+		id = g.last
+		i := Size - 1
+		for ; i >= timeSize; i-- {
+			id[i]++
+			if id[i] != 0 {
+				break
+			}
+		}
+		if i < timeSize {
+			return Nil, ErrOverflow
+		}
+	} else {
+		id.setTimestamp(uint64(ms))
+		if _, err := io.ReadFull(g.rand, id[timeSize:]); err != nil {
+			return Nil, err
+		}
+	}
+	g.last = id
+	return id, nil
+}
+
+// Parse parses the string form of an ID.
+func Parse(s string) (ID, error) {
+	var id ID
+	if len(s) != EncodedLen {
+		return Nil, ErrInvalid
+	}
+	b, err := base58.DecodeFixed(s, Size)
+	if err != nil {
+		return Nil, ErrInvalid
+	}
+	copy(id[:], b)
+	return id, nil
+}
+
+// String returns the 22-character base58 form of id.
+func (id ID) String() string {
+	s, _ := base58.EncodeFixed(id[:], EncodedLen)
+	return s
+}
+
+// Time returns the creation time of id, to the millisecond.
+func (id ID) Time() time.Time {
+	ms := int64(id.timestamp())
+	return time.Unix(ms/1000, ms%1000*int64(time.Millisecond))
+}
+
+// Compare returns -1, 0 or +1 as id sorts before, equal to or after other.
+func (id ID) Compare(other ID) int {
+	return bytes.Compare(id[:], other[:])
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (id ID) MarshalText() ([]byte, error) {
+	return []byte(id.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (id *ID) UnmarshalText(b []byte) error {
+	parsed, err := Parse(string(b))
+	if err != nil {
+		return err
+	}
+	*id = parsed
+	return nil
+}
+
+func (id *ID) timestamp() uint64 {
+	var ms uint64
+	for _, b := range id[:timeSize] {
+		ms = ms<<8 | uint64(b)
+	}
+	return ms
+}
+
+func (id *ID) setTimestamp(ms uint64) {
+	for i := timeSize - 1; i >= 0; i-- {
+		id[i] = byte(ms)
+		ms >>= 8
+	}
+}
@@ -0,0 +1,146 @@
+package id
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestString(t *testing.T) {
+	for _, id := range []ID{Nil, New(), {0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}} {
+		s := id.String()
+		if len(s) != EncodedLen {
+			t.Errorf("%x: got %q, want %d characters", id[:], s, EncodedLen)
+		}
+		parsed, err := Parse(s)
+		if err != nil || parsed != id {
+			t.Errorf("%q: got %x, %v", s, parsed[:], err)
+		}
+	}
+	if s := Nil.String(); s != strings.Repeat("1", EncodedLen) {
+		t.Errorf("got %q", s)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, s := range []string{
+		"",
+		strings.Repeat("1", EncodedLen-1),
+		strings.Repeat("1", EncodedLen+1),
+		strings.Repeat("1", EncodedLen-1) + "0",
+		strings.Repeat("z", EncodedLen), // more than 128 bits
+	} {
+		if _, err := Parse(s); err != ErrInvalid {
+			t.Errorf("%q: got %v, want ErrInvalid", s, err)
+		}
+	}
+}
+
+func TestTime(t *testing.T) {
+	g := NewGenerator(nil)
+	want := time.Date(2024, 5, 17, 12, 30, 45, 123456789, time.UTC)
+	id, err := g.NewAt(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := id.Time(); !got.Equal(want.Truncate(time.Millisecond)) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	for _, bad := range []time.Time{time.Unix(-1, 0), time.Date(10890, 1, 1, 0, 0, 0, 0, time.UTC)} {
+		if _, err := g.NewAt(bad); err != ErrTimeRange {
+			t.Errorf("%v: got %v, want ErrTimeRange", bad, err)
+		}
+	}
+}
+
+func TestMonotonic(t *testing.T) {
+	g := NewGenerator(nil)
+	now := time.Now()
+	ids := make([]ID, 0, 1000)
+	strs := make([]string, 0, 1000)
+	for i := 0; i < 1000; i++ {
+		// The clock stands still and then steps back.
+		at := now
+		if i > 500 {
+			at = now.Add(-time.Second)
+		}
+		id, err := g.NewAt(at)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i > 0 && id.Compare(ids[i-1]) <= 0 {
+			t.Fatalf("id %d does not increase", i)
+		}
+		ids = append(ids, id)
+		strs = append(strs, id.String())
+	}
+	if !sort.StringsAreSorted(strs) {
+		t.Error("strings do not sort like ids")
+	}
+
+	later, _ := g.NewAt(now.Add(time.Millisecond))
+	if later.Compare(ids[len(ids)-1]) <= 0 || !later.Time().After(ids[0].Time()) {
+		t.Error("id for a later time does not sort last")
+	}
+}
+
+func TestOverflow(t *testing.T) {
+	g := NewGenerator(bytes.NewReader(bytes.Repeat([]byte{0xff}, Size)))
+	now := time.Now()
+	if _, err := g.NewAt(now); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.NewAt(now); err != ErrOverflow {
+		t.Errorf("got %v, want ErrOverflow", err)
+	}
+}
+
+func TestText(t *testing.T) {
+	type record struct {
+		ID ID `json:"id"`
+	}
+	in := record{New()}
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"id":"` + in.ID.String() + `"}`; string(b) != want {
+		t.Errorf("got %s, want %s", b, want)
+	}
+	var out record
+	if err := json.Unmarshal(b, &out); err != nil || out != in {
+		t.Errorf("got %+v, %v", out, err)
+	}
+	if err := json.Unmarshal([]byte(`{"id":"nope"}`), &out); err == nil {
+		t.Error("expected an error for an invalid id")
+	}
+}
+
+func TestConcurrent(t *testing.T) {
+	const n = 8
+	ch := make(chan ID, n*1000)
+	done := make(chan bool)
+	for i := 0; i < n; i++ {
+		go func() {
+			for j := 0; j < 1000; j++ {
+				ch <- New()
+			}
+			done <- true
+		}()
+	}
+	for i := 0; i < n; i++ {
+		<-done
+	}
+	close(ch)
+	seen := make(map[ID]bool)
+	for id := range ch {
+		if seen[id] {
+			t.Fatalf("duplicate id %s", id)
+		}
+		seen[id] = true
+	}
+}
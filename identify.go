@@ -0,0 +1,267 @@
+package base58
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Confidence levels used to rank candidates. A checksum or an explicit textual
+// prefix makes a match near certain, while raw byte lengths only hint at a
+// format.
+const (
+	confidencePrefixed = 95
+	confidenceChecksum = 90
+	confidenceShape    = 80
+	confidenceLength   = 40
+	confidenceAny      = 10
+)
+
+// Candidate is one possible interpretation of a base58 string.
+type Candidate struct {
+	// Format names the recognized format, such as "address", "wif",
+	// "bip32", "cidv0" or "ss58".
+	Format string
+	// Alphabet names the alphabet the string was decoded with: "btc",
+	// "flickr" or "ripple".
+	Alphabet string
+	// Confidence ranks the candidate from 0 to 100.
+	Confidence int
+	// Decoded holds the raw decoded bytes, including any checksum.
+	Decoded []byte
+	// Fields describes the decoded structure, such as the version, network
+	// or key type. Binary values are hex encoded.
+	Fields map[string]string
+}
+
+// Identify tries the known alphabets, lengths, prefixes and checksum schemes
+// against s and returns the matching interpretations, most likely first. It
+// returns nil if s is not valid base58 in any known alphabet.
+func Identify(s string) []Candidate {
+	var cs []Candidate
+
+	if raw, err := Decode(s); err == nil {
+		cs = append(cs, identifyBTC(s, raw)...)
+		if len(cs) == 0 {
+			cs = append(cs, Candidate{Format: "base58", Alphabet: "btc", Confidence: confidenceAny, Decoded: raw})
+		}
+	}
+	if raw, err := DecodeAlphabet(s, FlickrAlphabet); err == nil {
+		cs = append(cs, identifyFlickr(raw))
+	}
+	if raw, err := DecodeAlphabet(s, RippleAlphabet); err == nil {
+		cs = append(cs, identifyRipple(raw)...)
+	}
+	if k, err := ParseEOSKey(s); err == nil {
+		// The checksum was verified, so the decoded body is the data
+		// followed by its checksum.
+		sum := k.checksum()
+		raw := make([]byte, 0, len(k.Data)+eosChecksumSize)
+		raw = append(raw, k.Data...)
+		raw = append(raw, sum[:eosChecksumSize]...)
+		cs = append(cs, Candidate{
+			Format:     "eos-key",
+			Alphabet:   "btc",
+			Confidence: confidencePrefixed,
+			Decoded:    raw,
+			Fields: map[string]string{
+				"kind":   k.Kind,
+				"curve":  k.Curve,
+				"legacy": strconv.FormatBool(k.Legacy),
+				"data":   hex.EncodeToString(k.Data),
+			},
+		})
+	}
+
+	sort.SliceStable(cs, func(i, j int) bool { return cs[i].Confidence > cs[j].Confidence })
+	return cs
+}
+
+func identifyBTC(s string, raw []byte) []Candidate {
+	var cs []Candidate
+	add := func(format string, confidence int, fields map[string]string) {
+		cs = append(cs, Candidate{Format: format, Alphabet: "btc", Confidence: confidence, Decoded: raw, Fields: fields})
+	}
+
+	if payload, err := checkPayload(raw); err == nil {
+		if n, t, hash, err := decodeAddress(s); err == nil {
+			add("address", confidenceChecksum, map[string]string{
+				"network": n.Name,
+				"type":    t.String(),
+				"version": hex.EncodeToString(payload[:len(payload)-len(hash)]),
+				"hash":    hex.EncodeToString(hash),
+			})
+		}
+		if f := identifyWIF(payload); f != nil {
+			add("wif", confidenceChecksum, f)
+		}
+		if f := identifyBIP32(payload); f != nil {
+			add("bip32", confidencePrefixed, f)
+		}
+		if f := identifyTezos(payload); f != nil {
+			add("tezos", confidenceChecksum, f)
+		}
+	}
+
+	if a, err := SS58Decode(s); err == nil {
+		add("ss58", confidenceChecksum, map[string]string{
+			"prefix":  strconv.Itoa(int(a.Prefix)),
+			"network": a.Network(),
+			"payload": hex.EncodeToString(a.Payload),
+		})
+	}
+	if a, err := ByronDecode(s); err == nil {
+		f := map[string]string{
+			"root": hex.EncodeToString(a.Root),
+			"type": strconv.FormatUint(a.Type, 10),
+		}
+		if magic, ok := a.NetworkMagic(); ok {
+			f["network_magic"] = strconv.FormatUint(uint64(magic), 10)
+		}
+		add("cardano-byron", confidencePrefixed, f)
+	}
+
+	if len(s) == 46 && strings.HasPrefix(s, "Qm") && len(raw) == 34 && raw[0] == 0x12 && raw[1] == 0x20 {
+		add("cidv0", confidenceShape, map[string]string{
+			"hash":   "sha2-256",
+			"digest": hex.EncodeToString(raw[2:]),
+		})
+	}
+
+	// Solana uses bare base58 without version or checksum, so only the
+	// length hints at it.
+	switch len(raw) {
+	case 32:
+		add("solana-pubkey", confidenceLength, nil)
+	case 64:
+		add("solana-signature", confidenceLength, nil)
+	}
+
+	return cs
+}
+
+func identifyWIF(payload []byte) map[string]string {
+	if len(payload) != 33 && !(len(payload) == 34 && payload[33] == 0x01) {
+		return nil
+	}
+	var network string
+	switch payload[0] {
+	case 0x80:
+		network = "bitcoin"
+	case 0xef:
+		network = "bitcoin-testnet"
+	default:
+		return nil
+	}
+	return map[string]string{
+		"network":    network,
+		"compressed": strconv.FormatBool(len(payload) == 34),
+	}
+}
+
+var bip32Versions = map[uint32]string{
+	0x0488b21e: "xpub",
+	0x0488ade4: "xprv",
+	0x043587cf: "tpub",
+	0x04358394: "tprv",
+	0x049d7cb2: "ypub",
+	0x049d7878: "yprv",
+	0x04b24746: "zpub",
+	0x04b2430c: "zprv",
+}
+
+func identifyBIP32(payload []byte) map[string]string {
+	if len(payload) != 78 {
+		return nil
+	}
+	kind, ok := bip32Versions[binary.BigEndian.Uint32(payload)]
+	if !ok {
+		return nil
+	}
+	return map[string]string{
+		"kind":        kind,
+		"depth":       strconv.Itoa(int(payload[4])),
+		"fingerprint": hex.EncodeToString(payload[5:9]),
+		"child":       strconv.FormatUint(uint64(binary.BigEndian.Uint32(payload[9:13])), 10),
+		"chain_code":  hex.EncodeToString(payload[13:45]),
+		"key":         hex.EncodeToString(payload[45:]),
+	}
+}
+
+var tezosPrefixes = []struct {
+	kind   string
+	prefix []byte
+	size   int
+}{
+	{"tz1", []byte{0x06, 0xa1, 0x9f}, 20},
+	{"tz2", []byte{0x06, 0xa1, 0xa1}, 20},
+	{"tz3", []byte{0x06, 0xa1, 0xa4}, 20},
+	{"KT1", []byte{0x02, 0x5a, 0x79}, 20},
+	{"block", []byte{0x01, 0x34}, 32},
+	{"operation", []byte{0x05, 0x74}, 32},
+	{"protocol", []byte{0x02, 0xaa}, 32},
+	{"chain_id", []byte{0x57, 0x52, 0x00}, 4},
+	{"edpk", []byte{0x0d, 0x0f, 0x25, 0xd9}, 32},
+	{"edsig", []byte{0x09, 0xf5, 0xcd, 0x86, 0x12}, 64},
+}
+
+func identifyTezos(payload []byte) map[string]string {
+	for _, p := range tezosPrefixes {
+		if len(payload) == len(p.prefix)+p.size && bytes.HasPrefix(payload, p.prefix) {
+			return map[string]string{
+				"kind": p.kind,
+				"data": hex.EncodeToString(payload[len(p.prefix):]),
+			}
+		}
+	}
+	return nil
+}
+
+// identifyFlickr returns a Base58Check candidate if raw carries a checksum,
+// and a generic one otherwise. No formats are registered for Flickr, so the
+// version byte is reported as is.
+func identifyFlickr(raw []byte) Candidate {
+	payload, err := checkPayload(raw)
+	if err != nil || len(payload) == 0 {
+		return Candidate{Format: "base58", Alphabet: "flickr", Confidence: confidenceAny, Decoded: raw}
+	}
+	return Candidate{
+		Format:     "base58check",
+		Alphabet:   "flickr",
+		Confidence: confidenceChecksum,
+		Decoded:    raw,
+		Fields: map[string]string{
+			"version": hex.EncodeToString(payload[:1]),
+			"data":    hex.EncodeToString(payload[1:]),
+		},
+	}
+}
+
+func identifyRipple(raw []byte) []Candidate {
+	payload, err := checkPayload(raw)
+	if err != nil {
+		return nil
+	}
+	var kind string
+	switch {
+	case len(payload) == 21 && payload[0] == 0x00:
+		kind = "account"
+	case len(payload) == 17 && payload[0] == 0x21:
+		kind = "seed"
+	default:
+		return nil
+	}
+	return []Candidate{{
+		Format:     "ripple",
+		Alphabet:   "ripple",
+		Confidence: confidenceChecksum,
+		Decoded:    raw,
+		Fields: map[string]string{
+			"kind": kind,
+			"data": hex.EncodeToString(payload[1:]),
+		},
+	}}
+}
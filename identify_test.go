@@ -0,0 +1,85 @@
+package base58
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func TestIdentify(t *testing.T) {
+	tezos := CheckEncode(append([]byte{0x06, 0xa1, 0x9f}, make([]byte, 20)...))
+
+	tests := []struct {
+		in     string
+		format string
+		field  string
+		value  string
+	}{
+		{"1QCaxc8hutpdZ62iKZsn1TCG3nh7uPZojq", "address", "network", "bitcoin"},
+		{"5HueCGU8rMjxEXxiPuD5BDku4MkFqeZyd4dZ1jvhTVqvbTLvyTJ", "wif", "compressed", "false"},
+		{"xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8", "bip32", "kind", "xpub"},
+		{"QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG", "cidv0", "hash", "sha2-256"},
+		{"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "ripple", "kind", "account"},
+		{"5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY", "ss58", "network", "substrate"},
+		{"Ae2tdPwUPEZFRbyhz3cpfC2CumGzNkFBN2L42rcUc2yjQpEkxDbkPodpMAi", "cardano-byron", "type", "0"},
+		{eosLegacyKey, "eos-key", "kind", "PUB"},
+		{tezos, "tezos", "kind", "tz1"},
+		{"11111111111111111111111111111111", "solana-pubkey", "", ""},
+		{"3mJr7AoUXx2Wqd", "base58", "", ""},
+	}
+
+	for _, tt := range tests {
+		cs := Identify(tt.in)
+		if len(cs) == 0 {
+			t.Errorf("Identify(%s): no candidates", tt.in)
+			continue
+		}
+		if cs[0].Format != tt.format {
+			t.Errorf("Identify(%s): best candidate %s, want %s", tt.in, cs[0].Format, tt.format)
+			continue
+		}
+		if tt.field != "" && cs[0].Fields[tt.field] != tt.value {
+			t.Errorf("Identify(%s): %s = %q, want %q", tt.in, tt.field, cs[0].Fields[tt.field], tt.value)
+		}
+	}
+}
+
+func TestIdentifyDecodedKeepsChecksum(t *testing.T) {
+	body, _ := Decode(eosLegacyKey[len(eosLegacyPrefix):])
+	for _, c := range Identify(eosLegacyKey) {
+		if c.Format != "eos-key" {
+			continue
+		}
+		if !bytes.Equal(c.Decoded, body) {
+			t.Errorf("eos-key Decoded = %x, want %x", c.Decoded, body)
+		}
+		if c.Fields["data"] != hex.EncodeToString(body[:len(body)-eosChecksumSize]) {
+			t.Errorf("eos-key data = %s", c.Fields["data"])
+		}
+		return
+	}
+	t.Errorf("Identify(%s): no eos-key candidate", eosLegacyKey)
+}
+
+func TestIdentifyFlickr(t *testing.T) {
+	s := CheckEncodeAlphabet([]byte{0x05, 0xde, 0xad, 0xbe, 0xef}, FlickrAlphabet)
+	cs := Identify(s)
+	if len(cs) == 0 || cs[0].Format != "base58check" || cs[0].Alphabet != "flickr" ||
+		cs[0].Fields["version"] != "05" || cs[0].Fields["data"] != "deadbeef" {
+		t.Errorf("Identify(%s) = %+v", s, cs)
+	}
+
+	var generic bool
+	for _, c := range Identify("3mJr7AoUXx2Wqd") {
+		generic = generic || (c.Format == "base58" && c.Alphabet == "flickr")
+	}
+	if !generic {
+		t.Errorf("Identify(3mJr7AoUXx2Wqd): no generic flickr candidate")
+	}
+}
+
+func TestIdentifyInvalid(t *testing.T) {
+	if cs := Identify("0OIl"); cs != nil {
+		t.Errorf("Identify of invalid base58 returned %v", cs)
+	}
+}
@@ -0,0 +1,109 @@
+package base58
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// maxUint64Digits is the length of the base58 encoding of math.MaxUint64.
+const maxUint64Digits = 11
+
+// EncodeUint64 encodes n as a plain base58 number: 0 encodes as the zero
+// digit and, unlike Encode, there are no leading zero bytes to preserve.
+func EncodeUint64(n uint64) string {
+	return EncodeUint64Alphabet(n, BTCAlphabet)
+}
+
+// EncodeUint64Alphabet encodes n as a base58 number with the passed alphabet.
+func EncodeUint64Alphabet(n uint64, alphabet *Alphabet) string {
+	var buf [maxUint64Digits]byte
+	i := len(buf)
+	for {
+		i--
+		buf[i] = alphabet.encode[n%58]
+		n /= 58
+		if n == 0 {
+			break
+		}
+	}
+	return string(buf[i:])
+}
+
+// DecodeUint64 decodes a base58 number, ignoring leading zero digits. It
+// returns ErrOverflow if the value does not fit in a uint64.
+func DecodeUint64(str string) (uint64, error) {
+	return DecodeUint64Alphabet(str, BTCAlphabet)
+}
+
+// DecodeUint64Alphabet decodes a base58 number using the given alphabet.
+func DecodeUint64Alphabet(str string, alphabet *Alphabet) (uint64, error) {
+	if len(str) == 0 {
+		return 0, fmt.Errorf("zero length string")
+	}
+	const cutoff, cutlim = math.MaxUint64 / 58, math.MaxUint64 % 58
+	var n uint64
+	for i := 0; i < len(str); i++ {
+		if str[i] > 127 || alphabet.decode[str[i]] == -1 {
+			return 0, invalidDigitError(str, i)
+		}
+		d := uint64(alphabet.decode[str[i]])
+		if n > cutoff || n == cutoff && d > cutlim {
+			return 0, ErrOverflow
+		}
+		n = n*58 + d
+	}
+	return n, nil
+}
+
+// EncodeBigInt encodes n as a base58 number. It returns ErrNegative if n is
+// negative.
+func EncodeBigInt(n *big.Int) (string, error) {
+	return EncodeBigIntAlphabet(n, BTCAlphabet)
+}
+
+// EncodeBigIntAlphabet encodes n as a base58 number with the passed alphabet.
+func EncodeBigIntAlphabet(n *big.Int, alphabet *Alphabet) (string, error) {
+	if n.Sign() < 0 {
+		return "", ErrNegative
+	}
+	if n.IsUint64() {
+		return EncodeUint64Alphabet(n.Uint64(), alphabet), nil
+	}
+
+	digits := make([]byte, (n.BitLen()+7)/8*555/406+1)
+	largeEncodeDigits(new(big.Int).Set(n), digits)
+	i := 0
+	for digits[i] == 0 {
+		i++
+	}
+	out := digits[i:]
+	for j, d := range out {
+		out[j] = alphabet.encode[d]
+	}
+	return string(out), nil
+}
+
+// DecodeBigInt decodes a base58 number of any size.
+func DecodeBigInt(str string) (*big.Int, error) {
+	return DecodeBigIntAlphabet(str, BTCAlphabet)
+}
+
+// DecodeBigIntAlphabet decodes a base58 number of any size using the given
+// alphabet.
+func DecodeBigIntAlphabet(str string, alphabet *Alphabet) (*big.Int, error) {
+	if len(str) <= maxUint64Digits {
+		if n, err := DecodeUint64Alphabet(str, alphabet); err != ErrOverflow {
+			if err != nil {
+				return nil, err
+			}
+			return new(big.Int).SetUint64(n), nil
+		}
+	}
+
+	digits := make([]byte, len(str))
+	if n := translate(digits, str, alphabet); n < len(str) {
+		return nil, invalidDigitError(str, n)
+	}
+	return largeDecodeDigits(digits), nil
+}
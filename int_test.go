@@ -0,0 +1,119 @@
+package base58
+
+import (
+	"encoding/binary"
+	"math"
+	"math/big"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestUint64(t *testing.T) {
+	tests := []struct {
+		n uint64
+		s string
+	}{
+		{0, "1"},
+		{57, "z"},
+		{58, "21"},
+		{58 * 58, "211"},
+		{math.MaxUint64, "jpXCZedGfVQ"},
+	}
+	for _, tt := range tests {
+		if got := EncodeUint64(tt.n); got != tt.s {
+			t.Errorf("EncodeUint64(%d) = %q, want %q", tt.n, got, tt.s)
+		}
+		if got, err := DecodeUint64(tt.s); got != tt.n || err != nil {
+			t.Errorf("DecodeUint64(%q) = %d, %v, want %d", tt.s, got, err, tt.n)
+		}
+	}
+
+	// Leading zero digits do not change the value.
+	if got, err := DecodeUint64("111z"); got != 57 || err != nil {
+		t.Errorf("got %d, %v", got, err)
+	}
+}
+
+func TestUint64MatchesBytes(t *testing.T) {
+	for _, alph := range []*Alphabet{BTCAlphabet, FlickrAlphabet} {
+		zero := string(alph.encode[0])
+		for i := 0; i < 10000; i++ {
+			n := rand.Uint64() >> uint(rand.Intn(64))
+			var b [8]byte
+			binary.BigEndian.PutUint64(b[:], n)
+			want := strings.TrimLeft(EncodeAlphabet(b[:], alph), zero)
+			if want == "" {
+				want = zero
+			}
+			if got := EncodeUint64Alphabet(n, alph); got != want {
+				t.Fatalf("%d: got %q, want %q", n, got, want)
+			}
+			if got, err := DecodeUint64Alphabet(want, alph); got != n || err != nil {
+				t.Fatalf("%q: got %d, %v, want %d", want, got, err, n)
+			}
+		}
+	}
+}
+
+func TestDecodeUint64Errors(t *testing.T) {
+	for _, s := range []string{"jpXCZedGfVR", "jpXCZedGfVQ1", "zzzzzzzzzzzz"} {
+		if _, err := DecodeUint64(s); err != ErrOverflow {
+			t.Errorf("%q: got %v, want ErrOverflow", s, err)
+		}
+	}
+	for _, s := range []string{"", "0", "1l", "\xff"} {
+		if _, err := DecodeUint64(s); err == nil || err == ErrOverflow {
+			t.Errorf("%q: got %v", s, err)
+		}
+	}
+}
+
+func TestBigInt(t *testing.T) {
+	for _, size := range []int{0, 1, 8, 9, 32, 200, 5000} {
+		b := make([]byte, size)
+		rand.Read(b)
+		if size > 0 {
+			b[0] |= 1
+		}
+		n := new(big.Int).SetBytes(b)
+
+		want := Encode(b)
+		if size == 0 {
+			want = "1"
+		}
+		s, err := EncodeBigIntAlphabet(n, BTCAlphabet)
+		if s != want || err != nil {
+			t.Fatalf("size %d: got %q, %v, want %q", size, s, err, want)
+		}
+		got, err := DecodeBigInt(s)
+		if err != nil || got.Cmp(n) != 0 {
+			t.Fatalf("size %d: got %v, %v", size, got, err)
+		}
+		if got, _ := DecodeBigInt("111" + s); got.Cmp(n) != 0 {
+			t.Fatalf("size %d: leading zero digits changed the value", size)
+		}
+	}
+
+	if _, err := DecodeBigInt(strings.Repeat("z", 20) + "0"); err == nil {
+		t.Error("expected an error for an invalid digit")
+	}
+	if _, err := DecodeBigInt(""); err == nil {
+		t.Error("expected an error for an empty string")
+	}
+	if _, err := EncodeBigInt(big.NewInt(-1)); err != ErrNegative {
+		t.Errorf("got %v, want ErrNegative", err)
+	}
+}
+
+func BenchmarkEncodeUint64(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		EncodeUint64Alphabet(4379781061, FlickrAlphabet)
+	}
+}
+
+func BenchmarkDecodeUint64(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		DecodeUint64Alphabet("7F2wjz", FlickrAlphabet)
+	}
+}
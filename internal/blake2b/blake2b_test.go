@@ -0,0 +1,41 @@
+package blake2b
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+var vectors = []struct {
+	in  string
+	out string
+}{
+	{"", "786a02f742015903c6c6fd852552d272912f4740e15847618a86e217f71f5419d25e1031afee585313896444934eb04b903a685b1448b755d56f701afe9be2ce"},
+	{"abc", "ba80a53f981c4d0d6a2797b69f12f6e94c212f14685ac4b74b12bb6fdbffa2d17d87c5392aab792dc252d5de4533cc9518d38aa8dbf1925ab92386edd4009923"},
+}
+
+func TestSum512(t *testing.T) {
+	for _, v := range vectors {
+		sum := Sum512([]byte(v.in))
+		if got := hex.EncodeToString(sum[:]); got != v.out {
+			t.Errorf("Sum512(%q) = %s, want %s", v.in, got, v.out)
+		}
+	}
+}
+
+func TestWriteSplits(t *testing.T) {
+	// Exercise block boundaries: the last full block must be finalized, not
+	// compressed eagerly.
+	for _, n := range []int{127, 128, 129, 255, 256, 257, 1000} {
+		data := []byte(strings.Repeat("x", n))
+		want := Sum512(data)
+		for split := 0; split <= n; split += 37 {
+			h := New512()
+			h.Write(data[:split])
+			h.Write(data[split:])
+			if got := h.Sum(nil); hex.EncodeToString(got) != hex.EncodeToString(want[:]) {
+				t.Fatalf("len %d split %d: streaming sum differs from Sum512", n, split)
+			}
+		}
+	}
+}
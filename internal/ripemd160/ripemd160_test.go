@@ -0,0 +1,40 @@
+package ripemd160
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+var vectors = []struct {
+	in  string
+	out string
+}{
+	{"", "9c1185a5c5e9fc54612808977ee8f548b2258d31"},
+	{"abc", "8eb208f7e05d987a9b044a8e98c6b087f15a0bfc"},
+	{"message digest", "5d0689ef49d2fae572b881b123a85ffa21595f36"},
+	{"12345678901234567890123456789012345678901234567890123456789012345678901234567890", "9b752e45573d4b39f4dbd3323cab82bf63326bfb"},
+	{strings.Repeat("a", 1000000), "52783243c1697bdbe16d37f97f68f08325dc1528"},
+}
+
+func TestSum(t *testing.T) {
+	for _, v := range vectors {
+		sum := Sum([]byte(v.in))
+		if got := hex.EncodeToString(sum[:]); got != v.out {
+			t.Errorf("Sum(%.20q) = %s, want %s", v.in, got, v.out)
+		}
+	}
+}
+
+func TestWriteSplits(t *testing.T) {
+	data := []byte(strings.Repeat("0123456789", 20))
+	want := Sum(data)
+	for split := 0; split <= len(data); split++ {
+		h := New()
+		h.Write(data[:split])
+		h.Write(data[split:])
+		if got := h.Sum(nil); hex.EncodeToString(got) != hex.EncodeToString(want[:]) {
+			t.Fatalf("split %d: streaming sum differs from Sum", split)
+		}
+	}
+}
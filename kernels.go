@@ -0,0 +1,30 @@
+package base58
+
+// translateGeneric writes the digit values of the characters of src to dst
+// and returns the number of characters translated, which is less than
+// len(src) if src contains a character that is not in the alphabet.
+//
+// On amd64 and arm64, translate uses SIMD versions instead unless built with
+// the purego tag.
+func translateGeneric(dst []byte, src string, alphabet *Alphabet) int {
+	for i := 0; i < len(src); i++ {
+		c := src[i]
+		if c > 127 || alphabet.digits[c] == 0 {
+			return i
+		}
+		dst[i] = alphabet.digits[c] - 1
+	}
+	return len(src)
+}
+
+// mulAdd sets the big-endian 32 bit limbs z to z*m + c. The caller
+// guarantees that the result fits. The carry of each limb feeds the next, so
+// the loop is a serial chain that SIMD cannot speed up.
+func mulAdd(z []uint32, m, c uint32) {
+	carry := uint64(c)
+	for j := len(z) - 1; j >= 0; j-- {
+		t := uint64(z[j])*uint64(m) + carry
+		z[j] = uint32(t)
+		carry = t >> 32
+	}
+}
@@ -0,0 +1,56 @@
+//go:build amd64 && !purego
+// +build amd64,!purego
+
+package base58
+
+// CPU features used by the amd64 kernels, detected at startup.
+var (
+	hasSSSE3 bool
+	hasAVX2  bool
+)
+
+func init() {
+	maxID, _, _, _ := cpuid(0, 0)
+	if maxID < 1 {
+		return
+	}
+	_, _, ecx1, _ := cpuid(1, 0)
+	hasSSSE3 = ecx1&(1<<9) != 0
+
+	// AVX2 also needs the OS to save the YMM registers.
+	osxsave := ecx1&(1<<27) != 0
+	avx := ecx1&(1<<28) != 0
+	if maxID < 7 || !osxsave || !avx {
+		return
+	}
+	if eax, _ := xgetbv(); eax&6 != 6 {
+		return
+	}
+	_, ebx7, _, _ := cpuid(7, 0)
+	hasAVX2 = ebx7&(1<<5) != 0
+}
+
+func translate(dst []byte, src string, alphabet *Alphabet) int {
+	n := 0
+	switch {
+	case hasAVX2 && len(src) >= 32:
+		n = translateAVX2(dst, src, &alphabet.digits)
+	case hasSSSE3 && len(src) >= 16:
+		n = translateSSSE3(dst, src, &alphabet.digits)
+	}
+	return n + translateGeneric(dst[n:], src[n:], alphabet)
+}
+
+func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+
+func xgetbv() (eax, edx uint32)
+
+// translateSSSE3 and translateAVX2 translate whole 16 and 32 byte blocks of
+// src, stopping before the first block holding an invalid character, and
+// return the number of characters translated.
+//
+//go:noescape
+func translateSSSE3(dst []byte, src string, digits *[128]byte) int
+
+//go:noescape
+func translateAVX2(dst []byte, src string, digits *[128]byte) int
@@ -0,0 +1,171 @@
+//go:build !purego
+// +build !purego
+
+#include "textflag.h"
+
+DATA nibbleMask<>+0x00(SB)/8, $0x0f0f0f0f0f0f0f0f
+DATA nibbleMask<>+0x08(SB)/8, $0x0f0f0f0f0f0f0f0f
+DATA nibbleMask<>+0x10(SB)/8, $0x0f0f0f0f0f0f0f0f
+DATA nibbleMask<>+0x18(SB)/8, $0x0f0f0f0f0f0f0f0f
+GLOBL nibbleMask<>(SB), RODATA|NOPTR, $32
+
+DATA ones<>+0x00(SB)/8, $0x0101010101010101
+DATA ones<>+0x08(SB)/8, $0x0101010101010101
+DATA ones<>+0x10(SB)/8, $0x0101010101010101
+DATA ones<>+0x18(SB)/8, $0x0101010101010101
+GLOBL ones<>(SB), RODATA|NOPTR, $32
+
+// func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+TEXT ·cpuid(SB), NOSPLIT, $0-24
+	MOVL eaxArg+0(FP), AX
+	MOVL ecxArg+4(FP), CX
+	CPUID
+	MOVL AX, eax+8(FP)
+	MOVL BX, ebx+12(FP)
+	MOVL CX, ecx+16(FP)
+	MOVL DX, edx+20(FP)
+	RET
+
+// func xgetbv() (eax, edx uint32)
+TEXT ·xgetbv(SB), NOSPLIT, $0-8
+	MOVL $0, CX
+	XGETBV
+	MOVL AX, eax+0(FP)
+	MOVL DX, edx+4(FP)
+	RET
+
+// The digit table has 128 entries, while a byte shuffle looks up 16. Each
+// block is therefore looked up in the eight 16 byte rows of the table by the
+// low nibble of every character, and the row matching the high nibble is
+// kept. Characters with the high bit set match no row and come out as 0,
+// which is also the table's marker for invalid characters.
+
+// func translateSSSE3(dst []byte, src string, digits *[128]byte) int
+TEXT ·translateSSSE3(SB), NOSPLIT, $0-56
+	MOVQ dst_base+0(FP), DI
+	MOVQ src_base+24(FP), SI
+	MOVQ src_len+32(FP), CX
+	MOVQ digits+40(FP), AX
+	XORQ BX, BX
+
+	MOVOU 0(AX), X8
+	MOVOU 16(AX), X9
+	MOVOU 32(AX), X10
+	MOVOU 48(AX), X11
+	MOVOU 64(AX), X12
+	MOVOU 80(AX), X13
+	MOVOU 96(AX), X14
+	MOVOU 112(AX), X15
+	MOVOU nibbleMask<>(SB), X5
+	MOVOU ones<>(SB), X7
+
+	SUBQ $16, CX
+
+ssse3Loop:
+	CMPQ BX, CX
+	JGT  ssse3Done
+
+	MOVOU (SI)(BX*1), X0
+	MOVO  X0, X1
+	PSRLW $4, X1
+	PAND  X5, X1
+	PAND  X5, X0
+	PXOR  X2, X2
+	PXOR  X6, X6
+
+#define SSSE3_ROW(row) \
+	MOVO    row, X3 \
+	PSHUFB  X0, X3 \
+	MOVO    X1, X4 \
+	PCMPEQB X6, X4 \
+	PAND    X4, X3 \
+	POR     X3, X2 \
+	PADDB   X7, X6
+
+	SSSE3_ROW(X8)
+	SSSE3_ROW(X9)
+	SSSE3_ROW(X10)
+	SSSE3_ROW(X11)
+	SSSE3_ROW(X12)
+	SSSE3_ROW(X13)
+	SSSE3_ROW(X14)
+	SSSE3_ROW(X15)
+
+	PXOR     X3, X3
+	PCMPEQB  X2, X3
+	PMOVMSKB X3, DX
+	TESTL    DX, DX
+	JNZ      ssse3Done
+
+	PSUBB X7, X2
+	MOVOU X2, (DI)(BX*1)
+	ADDQ  $16, BX
+	JMP   ssse3Loop
+
+ssse3Done:
+	MOVQ BX, ret+48(FP)
+	RET
+
+// func translateAVX2(dst []byte, src string, digits *[128]byte) int
+TEXT ·translateAVX2(SB), NOSPLIT, $0-56
+	MOVQ dst_base+0(FP), DI
+	MOVQ src_base+24(FP), SI
+	MOVQ src_len+32(FP), CX
+	MOVQ digits+40(FP), AX
+	XORQ BX, BX
+
+	VBROADCASTI128 0(AX), Y8
+	VBROADCASTI128 16(AX), Y9
+	VBROADCASTI128 32(AX), Y10
+	VBROADCASTI128 48(AX), Y11
+	VBROADCASTI128 64(AX), Y12
+	VBROADCASTI128 80(AX), Y13
+	VBROADCASTI128 96(AX), Y14
+	VBROADCASTI128 112(AX), Y15
+	VMOVDQU nibbleMask<>(SB), Y5
+	VMOVDQU ones<>(SB), Y7
+
+	SUBQ $32, CX
+
+avx2Loop:
+	CMPQ BX, CX
+	JGT  avx2Done
+
+	VMOVDQU (SI)(BX*1), Y0
+	VPSRLW  $4, Y0, Y1
+	VPAND   Y5, Y1, Y1
+	VPAND   Y5, Y0, Y0
+	VPXOR   Y2, Y2, Y2
+	VPXOR   Y6, Y6, Y6
+
+#define AVX2_ROW(row) \
+	VPSHUFB  Y0, row, Y3 \
+	VPCMPEQB Y6, Y1, Y4 \
+	VPAND    Y4, Y3, Y3 \
+	VPOR     Y3, Y2, Y2 \
+	VPADDB   Y7, Y6, Y6
+
+	AVX2_ROW(Y8)
+	AVX2_ROW(Y9)
+	AVX2_ROW(Y10)
+	AVX2_ROW(Y11)
+	AVX2_ROW(Y12)
+	AVX2_ROW(Y13)
+	AVX2_ROW(Y14)
+	AVX2_ROW(Y15)
+
+	VPXOR     Y3, Y3, Y3
+	VPCMPEQB  Y3, Y2, Y3
+	VPMOVMSKB Y3, DX
+	TESTL     DX, DX
+	JNZ       avx2Done
+
+	VPSUBB  Y7, Y2, Y2
+	VMOVDQU Y2, (DI)(BX*1)
+	ADDQ    $32, BX
+	JMP     avx2Loop
+
+avx2Done:
+	VZEROUPPER
+	MOVQ BX, ret+48(FP)
+	RET
@@ -0,0 +1,21 @@
+//go:build arm64 && !purego
+// +build arm64,!purego
+
+package base58
+
+// translate uses the NEON kernel for whole blocks. Advanced SIMD is part of
+// the arm64 base architecture, so it needs no feature detection.
+func translate(dst []byte, src string, alphabet *Alphabet) int {
+	n := 0
+	if len(src) >= 16 {
+		n = translateNEON(dst, src, &alphabet.digits)
+	}
+	return n + translateGeneric(dst[n:], src[n:], alphabet)
+}
+
+// translateNEON translates whole 16 byte blocks of src, stopping before the
+// first block holding an invalid character, and returns the number of
+// characters translated.
+//
+//go:noescape
+func translateNEON(dst []byte, src string, digits *[128]byte) int
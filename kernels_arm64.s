@@ -0,0 +1,54 @@
+//go:build !purego
+// +build !purego
+
+#include "textflag.h"
+
+// A table lookup reads up to four registers, or 64 bytes, so the 128 entry
+// digit table takes two lookups: one by the character and one by the
+// character minus 64. Out of range indices produce 0, which is also the
+// table's marker for invalid characters, and characters with the high bit
+// set are out of range for both.
+
+// func translateNEON(dst []byte, src string, digits *[128]byte) int
+TEXT ·translateNEON(SB), NOSPLIT, $0-56
+	MOVD dst_base+0(FP), R0
+	MOVD src_base+24(FP), R1
+	MOVD src_len+32(FP), R2
+	MOVD digits+40(FP), R3
+	MOVD $0, R4
+
+	VLD1.P 64(R3), [V16.B16, V17.B16, V18.B16, V19.B16]
+	VLD1   (R3), [V20.B16, V21.B16, V22.B16, V23.B16]
+	VMOVI  $64, V24.B16
+	VMOVI  $1, V25.B16
+	VEOR   V26.B16, V26.B16, V26.B16
+
+	SUB $16, R2, R2
+
+neonLoop:
+	CMP  R2, R4
+	BGT  neonDone
+
+	VLD1  (R1), [V0.B16]
+	VTBL  V0.B16, [V16.B16, V17.B16, V18.B16, V19.B16], V1.B16
+	VSUB  V24.B16, V0.B16, V2.B16
+	VTBL  V2.B16, [V20.B16, V21.B16, V22.B16, V23.B16], V3.B16
+	VORR  V1.B16, V3.B16, V1.B16
+
+	// Stop if any lane is zero.
+	VCMEQ V26.B16, V1.B16, V4.B16
+	VMOV  V4.D[0], R5
+	VMOV  V4.D[1], R6
+	ORR   R5, R6, R5
+	CBNZ  R5, neonDone
+
+	VSUB  V25.B16, V1.B16, V1.B16
+	VST1  [V1.B16], (R0)
+	ADD   $16, R0, R0
+	ADD   $16, R1, R1
+	ADD   $16, R4, R4
+	B     neonLoop
+
+neonDone:
+	MOVD R4, ret+48(FP)
+	RET
@@ -0,0 +1,8 @@
+//go:build (!amd64 && !arm64) || purego
+// +build !amd64,!arm64 purego
+
+package base58
+
+func translate(dst []byte, src string, alphabet *Alphabet) int {
+	return translateGeneric(dst, src, alphabet)
+}
@@ -0,0 +1,39 @@
+package base58
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestTranslateEqGeneric(t *testing.T) {
+	for k := 0; k < 20; k++ {
+		alph := randAlphabet()
+		for _, n := range []int{0, 1, 15, 16, 17, 31, 32, 33, 63, 64, 100, 257} {
+			src := make([]byte, n)
+			for i := range src {
+				src[i] = alph.encode[rand.Intn(58)]
+			}
+			// Valid input, then input with one bad byte at every position.
+			testTranslate(t, alph, string(src))
+			for i := range src {
+				saved := src[i]
+				src[i] = byte(rand.Intn(256))
+				testTranslate(t, alph, string(src))
+				src[i] = saved
+			}
+		}
+	}
+}
+
+func testTranslate(t *testing.T, alph *Alphabet, src string) {
+	got := make([]byte, len(src))
+	want := make([]byte, len(src))
+	gn := translate(got, src, alph)
+	wn := translateGeneric(want, src, alph)
+	if gn != wn {
+		t.Fatalf("translate(%q) stopped at %d, generic at %d", src, gn, wn)
+	}
+	if string(got[:gn]) != string(want[:wn]) {
+		t.Fatalf("translate(%q) = %v, generic %v", src, got[:gn], want[:wn])
+	}
+}
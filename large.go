@@ -0,0 +1,160 @@
+package base58
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+)
+
+const (
+	// largeEncodeThreshold and largeDecodeThreshold are the input sizes, in
+	// bytes and characters respectively, above which the divide and conquer
+	// algorithms are used. The measured crossover is lower; the thresholds
+	// keep the allocation-light fast paths for keys, hashes and addresses.
+	largeEncodeThreshold = 128
+	largeDecodeThreshold = 256
+
+	// largeLeafDigits is the size of the pieces converted directly.
+	largeLeafDigits = 320
+
+	// wordDigits is the number of base58 digits that fit in a uint64.
+	wordDigits = 10
+	wordBase   = 430804206899405824 // 58^10
+)
+
+var bnWordBase = new(big.Int).SetUint64(wordBase)
+
+// largePowers caches 58^(largeLeafDigits * 2^i) by i.
+var largePowers struct {
+	sync.Mutex
+	p []*big.Int
+}
+
+// largePower returns 58^(largeLeafDigits * 2^i). The returned value must not
+// be modified.
+func largePower(i int) *big.Int {
+	largePowers.Lock()
+	defer largePowers.Unlock()
+	for len(largePowers.p) <= i {
+		var p *big.Int
+		if n := len(largePowers.p); n == 0 {
+			p = new(big.Int).Exp(bn58, big.NewInt(largeLeafDigits), nil)
+		} else {
+			p = new(big.Int).Mul(largePowers.p[n-1], largePowers.p[n-1])
+		}
+		largePowers.p = append(largePowers.p, p)
+	}
+	return largePowers.p[i]
+}
+
+// largeSplit returns the index of the largest cached power whose digit count
+// is smaller than n.
+func largeSplit(n int) int {
+	i := 0
+	for largeLeafDigits<<uint(i+1) < n {
+		i++
+	}
+	return i
+}
+
+// largeEncode is fastEncode for inputs above largeEncodeThreshold. The fast
+// algorithms are quadratic in the input length, which takes seconds for
+// megabyte inputs, so this splits the number by precomputed powers of 58 and
+// converts the halves recursively, letting math/big's subquadratic
+// multiplication and division do the heavy lifting. The output is identical.
+func largeEncode(bin []byte, alphabet *Alphabet) string {
+	zcount := 0
+	for zcount < len(bin) && bin[zcount] == 0 {
+		zcount++
+	}
+
+	n := new(big.Int).SetBytes(bin[zcount:])
+	digits := make([]byte, (len(bin)-zcount)*555/406+1)
+	largeEncodeDigits(n, digits)
+
+	// Drop the zero digits of the oversized buffer, then put back one per
+	// leading zero byte.
+	i := 0
+	for i < len(digits) && digits[i] == 0 {
+		i++
+	}
+	out := make([]byte, zcount+len(digits)-i)
+	for j := 0; j < zcount; j++ {
+		out[j] = alphabet.encode[0]
+	}
+	for j, d := range digits[i:] {
+		out[zcount+j] = alphabet.encode[d]
+	}
+	return string(out)
+}
+
+// largeEncodeDigits writes the base58 digit values of n, which must fit, to
+// out, padding with leading zero digits. n is clobbered.
+func largeEncodeDigits(n *big.Int, out []byte) {
+	if len(out) <= largeLeafDigits {
+		r := new(big.Int)
+		for end := len(out); end > 0; end -= wordDigits {
+			n.QuoRem(n, bnWordBase, r)
+			w := r.Uint64()
+			for j := end - 1; j >= 0 && j >= end-wordDigits; j-- {
+				out[j] = byte(w % 58)
+				w /= 58
+			}
+		}
+		return
+	}
+
+	i := largeSplit(len(out))
+	lo := largeLeafDigits << uint(i)
+	q, r := new(big.Int).QuoRem(n, largePower(i), new(big.Int))
+	largeEncodeDigits(q, out[:len(out)-lo])
+	largeEncodeDigits(r, out[len(out)-lo:])
+}
+
+// largeDecode is the divide and conquer counterpart of fastDecode, used
+// above largeDecodeThreshold.
+func largeDecode(str string, alphabet *Alphabet) ([]byte, error) {
+	digits := make([]byte, 0, len(str))
+	for _, r := range str {
+		if r > 127 {
+			return nil, fmt.Errorf("high-bit set on invalid digit")
+		}
+		if alphabet.decode[r] == -1 {
+			return nil, fmt.Errorf("invalid base58 digit (%q)", r)
+		}
+		digits = append(digits, byte(alphabet.decode[r]))
+	}
+
+	zcount := 0
+	for zcount < len(digits) && digits[zcount] == 0 {
+		zcount++
+	}
+
+	n := largeDecodeDigits(digits[zcount:])
+	out := make([]byte, zcount, zcount+(len(digits)-zcount)*406/555+1)
+	return append(out, n.Bytes()...), nil
+}
+
+// largeDecodeDigits returns the value of the base58 digit values.
+func largeDecodeDigits(digits []byte) *big.Int {
+	if len(digits) <= largeLeafDigits {
+		n := new(big.Int)
+		w := new(big.Int)
+		for start := 0; start < len(digits); {
+			var v, m uint64 = 0, 1
+			for end := start + wordDigits; start < end && start < len(digits); start++ {
+				v = v*58 + uint64(digits[start])
+				m *= 58
+			}
+			n.Mul(n, w.SetUint64(m))
+			n.Add(n, w.SetUint64(v))
+		}
+		return n
+	}
+
+	i := largeSplit(len(digits))
+	lo := largeLeafDigits << uint(i)
+	n := largeDecodeDigits(digits[:len(digits)-lo])
+	n.Mul(n, largePower(i))
+	return n.Add(n, largeDecodeDigits(digits[len(digits)-lo:]))
+}
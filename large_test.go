@@ -0,0 +1,89 @@
+package base58
+
+import (
+	"bytes"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestLargeEqFast(t *testing.T) {
+	sizes := []int{1, 2, 100, largeLeafDigits, largeLeafDigits + 1, 1000, 3000, 5000}
+	for _, alph := range []*Alphabet{BTCAlphabet, FlickrAlphabet, randAlphabet()} {
+		for _, size := range sizes {
+			for _, zeros := range []int{0, 1, 17} {
+				b := make([]byte, size)
+				rand.Read(b[zeros%size:])
+				for i := 0; i < zeros && i < size; i++ {
+					b[i] = 0
+				}
+
+				fe := fastEncode(b, alph)
+				le := largeEncode(b, alph)
+				if fe != le {
+					t.Fatalf("size %d zeros %d: encodings differ", size, zeros)
+				}
+
+				fd, ferr := fastDecode(fe, alph)
+				ld, lerr := largeDecode(fe, alph)
+				if ferr != nil || lerr != nil {
+					t.Fatalf("size %d zeros %d: decode errors %v, %v", size, zeros, ferr, lerr)
+				}
+				if !bytes.Equal(fd, b) || !bytes.Equal(ld, b) {
+					t.Fatalf("size %d zeros %d: decodings differ", size, zeros)
+				}
+			}
+		}
+	}
+}
+
+func TestLargeAllZero(t *testing.T) {
+	b := make([]byte, 3*largeEncodeThreshold)
+	enc := Encode(b)
+	if enc != strings.Repeat("1", len(b)) {
+		t.Fatalf("all zero input encoded to %d characters", len(enc))
+	}
+	dec, err := Decode(enc)
+	if err != nil || !bytes.Equal(dec, b) {
+		t.Fatalf("all zero input did not round trip: %v", err)
+	}
+}
+
+func TestLargeDecodeErrors(t *testing.T) {
+	long := strings.Repeat("2", largeDecodeThreshold)
+	for _, s := range []string{long + "0", long + "\xff"} {
+		_, ferr := fastDecode(s, BTCAlphabet)
+		_, lerr := largeDecode(s, BTCAlphabet)
+		if ferr == nil || lerr == nil || ferr.Error() != lerr.Error() {
+			t.Errorf("errors differ: %v, %v", ferr, lerr)
+		}
+	}
+}
+
+func benchmarkEncodeSize(b *testing.B, size int) {
+	data := make([]byte, size)
+	rand.Read(data)
+	b.SetBytes(int64(size))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Encode(data)
+	}
+}
+
+func benchmarkDecodeSize(b *testing.B, size int) {
+	data := make([]byte, size)
+	rand.Read(data)
+	enc := Encode(data)
+	b.SetBytes(int64(size))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Decode(enc)
+	}
+}
+
+func BenchmarkEncode1K(b *testing.B)  { benchmarkEncodeSize(b, 1<<10) }
+func BenchmarkEncode64K(b *testing.B) { benchmarkEncodeSize(b, 64<<10) }
+func BenchmarkEncode1M(b *testing.B)  { benchmarkEncodeSize(b, 1<<20) }
+func BenchmarkDecode1K(b *testing.B)  { benchmarkDecodeSize(b, 1<<10) }
+func BenchmarkDecode64K(b *testing.B) { benchmarkDecodeSize(b, 64<<10) }
+func BenchmarkDecode1M(b *testing.B)  { benchmarkDecodeSize(b, 1<<20) }
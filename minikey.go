@@ -0,0 +1,88 @@
+package base58
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+)
+
+// ErrMiniKey is returned for strings that are not valid mini private keys,
+// and when asked to generate a mini key of an unsupported length.
+var ErrMiniKey = errors.New("invalid mini private key")
+
+// ValidMiniKey reports whether s is a valid Casascius mini private key: a 22,
+// 26 or 30 character string starting with 'S', drawn from the bitcoin
+// alphabet, whose SHA-256 followed by '?' starts with a zero byte.
+func ValidMiniKey(s string) bool {
+	if !miniKeyLength(len(s)) || s[0] != 'S' {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] > 127 || BTCAlphabet.decode[s[i]] == -1 {
+			return false
+		}
+	}
+	return miniKeyCheck(s)
+}
+
+// MiniKeyToPrivateKey returns the 32 byte private key of a mini key, the
+// SHA-256 of the string itself.
+func MiniKeyToPrivateKey(s string) ([]byte, error) {
+	if !ValidMiniKey(s) {
+		return nil, ErrMiniKey
+	}
+	key := sha256.Sum256([]byte(s))
+	return key[:], nil
+}
+
+// MiniKeyToWIF converts a mini key to Wallet Import Format. Mini keys
+// predate compressed public keys, so the result is uncompressed.
+func MiniKeyToWIF(s string) (string, error) {
+	key, err := MiniKeyToPrivateKey(s)
+	if err != nil {
+		return "", err
+	}
+	return EncodeWIF(key, false)
+}
+
+// GenerateMiniKey returns a new random mini key of the given length, which
+// must be 22, 26 or 30. Randomness is drawn from crypto/rand.
+func GenerateMiniKey(length int) (string, error) {
+	if !miniKeyLength(length) {
+		return "", ErrMiniKey
+	}
+
+	key := make([]byte, length)
+	key[0] = 'S'
+	var buf [64]byte
+	for {
+		for i := 1; i < length; {
+			if _, err := rand.Read(buf[:]); err != nil {
+				return "", err
+			}
+			for _, b := range buf {
+				// Reject the top of the byte range to keep digits uniform.
+				if b >= 58*4 {
+					continue
+				}
+				key[i] = BTCAlphabet.encode[b%58]
+				i++
+				if i == length {
+					break
+				}
+			}
+		}
+		if s := string(key); miniKeyCheck(s) {
+			return s, nil
+		}
+	}
+}
+
+func miniKeyLength(n int) bool {
+	return n == 22 || n == 26 || n == 30
+}
+
+func miniKeyCheck(s string) bool {
+	sum := sha256.Sum256([]byte(s + "?"))
+	return sum[0] == 0
+}
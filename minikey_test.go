@@ -0,0 +1,85 @@
+package base58
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+const testMiniKey = "S6c56bnXQiBjk9mqSYE7ykVQ7NzrRy"
+
+func TestMiniKeyKnown(t *testing.T) {
+	if !ValidMiniKey(testMiniKey) {
+		t.Fatalf("%s not recognized as a mini key", testMiniKey)
+	}
+	key, err := MiniKeyToPrivateKey(testMiniKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const want = "4c7a9640c72dc2099f23715d0c8a0d8a35f8906e3cab61dd3f78b67bf887c9ab"
+	if got := hex.EncodeToString(key); got != want {
+		t.Errorf("private key = %s, want %s", got, want)
+	}
+
+	wif, err := MiniKeyToWIF(testMiniKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wif != "5JPy8Zg7z4P7RSLsiqcqyeAF1935zjNUdMxcDeVrtU1oarrgnB7" {
+		t.Errorf("MiniKeyToWIF = %s", wif)
+	}
+	back, compressed, err := DecodeWIF(wif)
+	if err != nil || compressed || !bytes.Equal(back, key) {
+		t.Errorf("DecodeWIF = %x, %v, %v", back, compressed, err)
+	}
+}
+
+func TestMiniKeyInvalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"S6c56bnXQiBjk9mqSYE7ykVQ7NzrRz", // fails the check hash
+		"A6c56bnXQiBjk9mqSYE7ykVQ7NzrRy", // wrong first character
+		"S6c56bnXQiBjk9mqSYE7ykVQ7NzrR",  // wrong length
+		"S6c56bnXQiBjk9mqSYE7ykVQ7Nzr0y", // not in the alphabet
+	} {
+		if ValidMiniKey(s) {
+			t.Errorf("ValidMiniKey(%q) = true", s)
+		}
+		if _, err := MiniKeyToPrivateKey(s); err != ErrMiniKey {
+			t.Errorf("MiniKeyToPrivateKey(%q): got %v", s, err)
+		}
+	}
+}
+
+func TestGenerateMiniKey(t *testing.T) {
+	for _, n := range []int{22, 30} {
+		s, err := GenerateMiniKey(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(s) != n || !ValidMiniKey(s) {
+			t.Errorf("GenerateMiniKey(%d) = %q, not a valid mini key", n, s)
+		}
+	}
+	if _, err := GenerateMiniKey(23); err != ErrMiniKey {
+		t.Errorf("GenerateMiniKey(23): got %v", err)
+	}
+}
+
+func TestWIFCompressed(t *testing.T) {
+	key := bytes.Repeat([]byte{7}, 32)
+	wif, err := EncodeWIF(key, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wif[0] != 'K' && wif[0] != 'L' {
+		t.Errorf("compressed WIF %s should start with K or L", wif)
+	}
+	back, compressed, err := DecodeWIF(wif)
+	if err != nil || !compressed || !bytes.Equal(back, key) {
+		t.Errorf("DecodeWIF = %x, %v, %v", back, compressed, err)
+	}
+	if _, err := EncodeWIF(key[:31], false); err != ErrWIF {
+		t.Errorf("short key: got %v", err)
+	}
+}
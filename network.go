@@ -0,0 +1,186 @@
+package base58
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+)
+
+// AddressType is the kind of hash an address commits to.
+type AddressType int
+
+// Address types.
+const (
+	PubKeyHash AddressType = iota
+	ScriptHash
+)
+
+func (t AddressType) String() string {
+	switch t {
+	case PubKeyHash:
+		return "p2pkh"
+	case ScriptHash:
+		return "p2sh"
+	}
+	return "unknown"
+}
+
+var (
+	// ErrUnknownNetwork is returned when an address does not match any
+	// registered network.
+	ErrUnknownNetwork = errors.New("address does not match a registered network")
+	// ErrAddressType is returned when a network has no version bytes for the
+	// requested address type.
+	ErrAddressType = errors.New("address type not supported by network")
+	// ErrHashLength is returned when encoding a hash that is not 20 bytes
+	// long.
+	ErrHashLength = errors.New("address hash must be 20 bytes")
+	// ErrNetworkExists is returned when registering a network under a name
+	// that is already taken.
+	ErrNetworkExists = errors.New("network already registered")
+)
+
+const addressHashSize = 20
+
+// Network holds the address version bytes of a coin network. Many coins use
+// Base58Check addresses that differ from Bitcoin's only in the version bytes
+// prepended to a 20 byte hash, so registered networks let addresses be
+// identified and converted between them.
+type Network struct {
+	// Name is the registry key, such as "bitcoin" or "litecoin".
+	Name string
+	// PubKeyHash is the version prefix of pay-to-pubkey-hash addresses, or
+	// nil if the network has none.
+	PubKeyHash []byte
+	// ScriptHash is the version prefix of pay-to-script-hash addresses, or
+	// nil if the network has none.
+	ScriptHash []byte
+}
+
+// Well-known networks, registered by default.
+var (
+	BitcoinNetwork        = &Network{Name: "bitcoin", PubKeyHash: []byte{0x00}, ScriptHash: []byte{0x05}}
+	BitcoinTestnetNetwork = &Network{Name: "bitcoin-testnet", PubKeyHash: []byte{0x6f}, ScriptHash: []byte{0xc4}}
+	LitecoinNetwork       = &Network{Name: "litecoin", PubKeyHash: []byte{0x30}, ScriptHash: []byte{0x32}}
+	DogecoinNetwork       = &Network{Name: "dogecoin", PubKeyHash: []byte{0x1e}, ScriptHash: []byte{0x16}}
+	DashNetwork           = &Network{Name: "dash", PubKeyHash: []byte{0x4c}, ScriptHash: []byte{0x10}}
+	ZcashNetwork          = &Network{Name: "zcash", PubKeyHash: []byte{0x1c, 0xb8}, ScriptHash: []byte{0x1c, 0xbd}}
+	TronNetwork           = &Network{Name: "tron", PubKeyHash: []byte{0x41}}
+	NeoNetwork            = &Network{Name: "neo", ScriptHash: []byte{0x35}}
+)
+
+var networks = struct {
+	sync.RWMutex
+	list   []*Network
+	byName map[string]*Network
+}{byName: make(map[string]*Network)}
+
+func init() {
+	for _, n := range []*Network{
+		BitcoinNetwork,
+		BitcoinTestnetNetwork,
+		LitecoinNetwork,
+		DogecoinNetwork,
+		DashNetwork,
+		ZcashNetwork,
+		TronNetwork,
+		NeoNetwork,
+	} {
+		if err := RegisterNetwork(n); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// RegisterNetwork adds a network to the registry. Networks are matched in
+// registration order, so when two networks share version bytes DetectNetwork
+// reports the one registered first.
+func RegisterNetwork(n *Network) error {
+	networks.Lock()
+	defer networks.Unlock()
+	if _, ok := networks.byName[n.Name]; ok {
+		return ErrNetworkExists
+	}
+	networks.byName[n.Name] = n
+	networks.list = append(networks.list, n)
+	return nil
+}
+
+// LookupNetwork returns the registered network with the passed name.
+func LookupNetwork(name string) (*Network, bool) {
+	networks.RLock()
+	defer networks.RUnlock()
+	n, ok := networks.byName[name]
+	return n, ok
+}
+
+// Networks returns all registered networks in registration order.
+func Networks() []*Network {
+	networks.RLock()
+	defer networks.RUnlock()
+	return append([]*Network(nil), networks.list...)
+}
+
+// Version returns the version prefix for the address type, or nil if the
+// network does not support it.
+func (n *Network) Version(t AddressType) []byte {
+	switch t {
+	case PubKeyHash:
+		return n.PubKeyHash
+	case ScriptHash:
+		return n.ScriptHash
+	}
+	return nil
+}
+
+// Encode encodes a 20 byte hash as an address of the passed type.
+func (n *Network) Encode(t AddressType, hash []byte) (string, error) {
+	version := n.Version(t)
+	if version == nil {
+		return "", ErrAddressType
+	}
+	if len(hash) != addressHashSize {
+		return "", ErrHashLength
+	}
+	payload := make([]byte, 0, len(version)+len(hash))
+	payload = append(payload, version...)
+	payload = append(payload, hash...)
+	return CheckEncode(payload), nil
+}
+
+// DetectNetwork reports which registered network and address type the
+// address belongs to.
+func DetectNetwork(addr string) (*Network, AddressType, error) {
+	n, t, _, err := decodeAddress(addr)
+	return n, t, err
+}
+
+// ConvertAddress re-encodes the hash of an address from any registered
+// network under the version bytes of another network, keeping the address
+// type.
+func ConvertAddress(addr string, to *Network) (string, error) {
+	_, t, hash, err := decodeAddress(addr)
+	if err != nil {
+		return "", err
+	}
+	return to.Encode(t, hash)
+}
+
+func decodeAddress(addr string) (*Network, AddressType, []byte, error) {
+	payload, err := CheckDecode(addr)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	networks.RLock()
+	defer networks.RUnlock()
+	for _, n := range networks.list {
+		for _, t := range []AddressType{PubKeyHash, ScriptHash} {
+			version := n.Version(t)
+			if version != nil && len(payload) == len(version)+addressHashSize && bytes.HasPrefix(payload, version) {
+				return n, t, payload[len(version):], nil
+			}
+		}
+	}
+	return nil, 0, nil, ErrUnknownNetwork
+}
@@ -0,0 +1,95 @@
+package base58
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckEncodeDecode(t *testing.T) {
+	const addr = "1QCaxc8hutpdZ62iKZsn1TCG3nh7uPZojq"
+	payload, err := CheckDecode(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(payload) != 21 || payload[0] != 0 {
+		t.Fatalf("unexpected payload %x", payload)
+	}
+	if enc := CheckEncode(payload); enc != addr {
+		t.Errorf("CheckEncode = %s, want %s", enc, addr)
+	}
+
+	if _, err := CheckDecode("1QCaxc8hutpdZ62iKZsn1TCG3nh7uPZojr"); err != ErrChecksum {
+		t.Errorf("bad checksum: got %v", err)
+	}
+	if _, err := CheckDecode("111"); err != ErrCheckLength {
+		t.Errorf("short input: got %v", err)
+	}
+}
+
+func TestConvertAddress(t *testing.T) {
+	const btc = "1QCaxc8hutpdZ62iKZsn1TCG3nh7uPZojq"
+	lead := map[*Network]string{
+		BitcoinNetwork:  "1",
+		LitecoinNetwork: "L",
+		DogecoinNetwork: "D",
+		DashNetwork:     "X",
+		ZcashNetwork:    "t1",
+		TronNetwork:     "T",
+	}
+	for n, prefix := range lead {
+		addr, err := ConvertAddress(btc, n)
+		if err != nil {
+			t.Fatalf("%s: %v", n.Name, err)
+		}
+		if !strings.HasPrefix(addr, prefix) {
+			t.Errorf("%s: address %s does not start with %s", n.Name, addr, prefix)
+		}
+		got, typ, err := DetectNetwork(addr)
+		if err != nil {
+			t.Fatalf("%s: %v", n.Name, err)
+		}
+		if got != n || typ != PubKeyHash {
+			t.Errorf("%s: detected %s %s", n.Name, got.Name, typ)
+		}
+		back, err := ConvertAddress(addr, BitcoinNetwork)
+		if err != nil || back != btc {
+			t.Errorf("%s: converting back gave %s, %v", n.Name, back, err)
+		}
+	}
+
+	if _, err := ConvertAddress(btc, NeoNetwork); err != ErrAddressType {
+		t.Errorf("p2pkh to neo: got %v", err)
+	}
+}
+
+func TestDetectScriptHash(t *testing.T) {
+	hash := make([]byte, 20)
+	for _, n := range []*Network{BitcoinNetwork, ZcashNetwork, NeoNetwork} {
+		addr, err := n.Encode(ScriptHash, hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, typ, err := DetectNetwork(addr)
+		if err != nil || got != n || typ != ScriptHash {
+			t.Errorf("%s: detected %v %s %v", n.Name, got, typ, err)
+		}
+	}
+	if addr, _ := NeoNetwork.Encode(ScriptHash, hash); addr[0] != 'N' {
+		t.Errorf("neo address %s does not start with N", addr)
+	}
+	if addr, _ := ZcashNetwork.Encode(ScriptHash, hash); !strings.HasPrefix(addr, "t3") {
+		t.Errorf("zcash address %s does not start with t3", addr)
+	}
+}
+
+func TestRegisterNetwork(t *testing.T) {
+	if err := RegisterNetwork(&Network{Name: "bitcoin"}); err != ErrNetworkExists {
+		t.Errorf("duplicate name: got %v", err)
+	}
+	if n, ok := LookupNetwork("dash"); !ok || n != DashNetwork {
+		t.Errorf("LookupNetwork(dash) = %v, %v", n, ok)
+	}
+	if _, _, err := DetectNetwork(CheckEncode(make([]byte, 5))); err != ErrUnknownNetwork {
+		t.Errorf("unknown payload: got %v", err)
+	}
+}
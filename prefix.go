@@ -0,0 +1,132 @@
+package base58
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ErrPrefixNotFound is returned when no identifier starts with a prefix.
+var ErrPrefixNotFound = errors.New("no identifier with this prefix")
+
+// maxAmbiguousCandidates caps the candidates listed by an
+// AmbiguousPrefixError.
+const maxAmbiguousCandidates = 10
+
+// AmbiguousPrefixError is returned when several identifiers start with a
+// prefix.
+type AmbiguousPrefixError struct {
+	Prefix string
+	// Candidates lists the first matching identifiers in sorted order, at
+	// most ten of them.
+	Candidates []string
+	// Count is the number of matching identifiers.
+	Count int
+}
+
+func (e *AmbiguousPrefixError) Error() string {
+	list := strings.Join(e.Candidates, ", ")
+	if e.Count > len(e.Candidates) {
+		list += fmt.Sprintf(" and %d more", e.Count-len(e.Candidates))
+	}
+	return fmt.Sprintf("prefix %q is ambiguous: %s", e.Prefix, list)
+}
+
+// PrefixIndex resolves abbreviated identifiers, the way git resolves
+// abbreviated hashes. It keeps the identifiers sorted, so those sharing a
+// prefix are adjacent. The zero value is an empty index.
+type PrefixIndex struct {
+	ids []string
+}
+
+// NewPrefixIndex returns an index of the passed identifiers.
+func NewPrefixIndex(ids ...string) *PrefixIndex {
+	sorted := append([]string(nil), ids...)
+	sort.Strings(sorted)
+	n := 0
+	for i, id := range sorted {
+		if i == 0 || id != sorted[n-1] {
+			sorted[n] = id
+			n++
+		}
+	}
+	return &PrefixIndex{ids: sorted[:n]}
+}
+
+// Add adds id to the index. Adding an identifier twice has no effect.
+func (x *PrefixIndex) Add(id string) {
+	i := sort.SearchStrings(x.ids, id)
+	if i < len(x.ids) && x.ids[i] == id {
+		return
+	}
+	x.ids = append(x.ids, "")
+	copy(x.ids[i+1:], x.ids[i:])
+	x.ids[i] = id
+}
+
+// Len returns the number of identifiers in the index.
+func (x *PrefixIndex) Len() int {
+	return len(x.ids)
+}
+
+// UniquePrefixLen returns the length of the shortest prefix of id that
+// Resolve maps to id once id is in the index. That is len(id) if id is
+// itself a prefix of another identifier.
+func (x *PrefixIndex) UniquePrefixLen(id string) int {
+	// Only the sorted neighbours can share a longer prefix than any other
+	// identifier.
+	i := sort.SearchStrings(x.ids, id)
+	n := 0
+	if i > 0 {
+		n = commonPrefixLen(id, x.ids[i-1])
+	}
+	if i < len(x.ids) && x.ids[i] == id {
+		i++
+	}
+	if i < len(x.ids) {
+		if c := commonPrefixLen(id, x.ids[i]); c > n {
+			n = c
+		}
+	}
+	if n < len(id) {
+		n++
+	}
+	return n
+}
+
+// Resolve returns the identifier that starts with prefix. An identifier equal
+// to prefix always wins, as it could not be selected otherwise. It returns
+// ErrPrefixNotFound if there is none and an *AmbiguousPrefixError if there
+// are several.
+func (x *PrefixIndex) Resolve(prefix string) (string, error) {
+	i := sort.SearchStrings(x.ids, prefix)
+	j := i
+	for j < len(x.ids) && strings.HasPrefix(x.ids[j], prefix) {
+		j++
+	}
+	switch {
+	case i == j:
+		return "", ErrPrefixNotFound
+	case j-i == 1 || x.ids[i] == prefix:
+		return x.ids[i], nil
+	}
+
+	candidates := x.ids[i:j]
+	if len(candidates) > maxAmbiguousCandidates {
+		candidates = candidates[:maxAmbiguousCandidates]
+	}
+	return "", &AmbiguousPrefixError{
+		Prefix:     prefix,
+		Candidates: append([]string(nil), candidates...),
+		Count:      j - i,
+	}
+}
+
+func commonPrefixLen(a, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return n
+}
@@ -0,0 +1,102 @@
+package base58
+
+import (
+	"crypto/rand"
+	"strings"
+	"testing"
+)
+
+func TestPrefixIndexResolve(t *testing.T) {
+	x := NewPrefixIndex("3yQ9", "3yQxCB", "3yQxCz", "9Ajd", "9Aj", "3yQ9")
+	if x.Len() != 5 {
+		t.Fatalf("got %d ids, want 5", x.Len())
+	}
+
+	tests := []struct {
+		prefix, want string
+		err          error
+	}{
+		{"9", "", &AmbiguousPrefixError{}},
+		{"9Aj", "9Aj", nil},
+		{"9Ajd", "9Ajd", nil},
+		{"3yQ9", "3yQ9", nil},
+		{"3yQxCB", "3yQxCB", nil},
+		{"3yQx", "", &AmbiguousPrefixError{}},
+		{"zz", "", ErrPrefixNotFound},
+		{"3yQxCBB", "", ErrPrefixNotFound},
+	}
+	for _, tt := range tests {
+		got, err := x.Resolve(tt.prefix)
+		switch want := tt.err.(type) {
+		case *AmbiguousPrefixError:
+			if _, ok := err.(*AmbiguousPrefixError); !ok {
+				t.Errorf("%q: got %q, %v, want ambiguous", tt.prefix, got, err)
+			}
+		default:
+			if got != tt.want || err != want {
+				t.Errorf("%q: got %q, %v, want %q, %v", tt.prefix, got, err, tt.want, want)
+			}
+		}
+	}
+
+	_, err := x.Resolve("3yQx")
+	e := err.(*AmbiguousPrefixError)
+	if e.Count != 2 || strings.Join(e.Candidates, ",") != "3yQxCB,3yQxCz" {
+		t.Errorf("got %+v", e)
+	}
+	if msg := e.Error(); msg != `prefix "3yQx" is ambiguous: 3yQxCB, 3yQxCz` {
+		t.Errorf("got message %q", msg)
+	}
+}
+
+func TestPrefixIndexUniquePrefixLen(t *testing.T) {
+	var x PrefixIndex
+	for _, id := range []string{"3yQ9", "3yQxCB", "3yQxCz", "9Aj", "9Ajd"} {
+		x.Add(id)
+	}
+	x.Add("9Aj")
+
+	tests := []struct {
+		id   string
+		want int
+	}{
+		{"3yQ9", 4},
+		{"3yQxCB", 6},
+		{"9Aj", 3},
+		{"9Ajd", 4},
+		{"Z", 1},   // not in the index
+		{"3yR", 3}, // not in the index
+	}
+	for _, tt := range tests {
+		if got := x.UniquePrefixLen(tt.id); got != tt.want {
+			t.Errorf("%q: got %d, want %d", tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestPrefixIndexRandom(t *testing.T) {
+	ids := make([]string, 2000)
+	for i := range ids {
+		b := make([]byte, 16)
+		rand.Read(b)
+		ids[i] = Encode(b)
+	}
+	x := NewPrefixIndex(ids...)
+	for _, id := range ids {
+		n := x.UniquePrefixLen(id)
+		if got, err := x.Resolve(id[:n]); got != id || err != nil {
+			t.Fatalf("%s[:%d]: got %q, %v", id, n, got, err)
+		}
+		if n > 1 {
+			if _, err := x.Resolve(id[:n-1]); err == nil {
+				t.Fatalf("%s[:%d] should not be unique", id, n-1)
+			}
+		}
+	}
+
+	many := NewPrefixIndex(ids...)
+	_, err := many.Resolve("")
+	if e, ok := err.(*AmbiguousPrefixError); !ok || e.Count != len(ids) || len(e.Candidates) != maxAmbiguousCandidates {
+		t.Errorf("got %v", err)
+	}
+}
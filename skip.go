@@ -0,0 +1,37 @@
+package base58
+
+// DecodeSkipping decodes str like Decode but ignores ASCII whitespace and any
+// of the ASCII characters in separators, so wrapped or grouped output such as
+// "3yQ-xCB\n2cM" decodes as if it were written in one piece. Characters of
+// the alphabet are always digits, even if listed as separators. Any other
+// character yields a CorruptInputError holding its offset in str.
+func DecodeSkipping(str, separators string) ([]byte, error) {
+	return DecodeSkippingAlphabet(str, separators, BTCAlphabet)
+}
+
+// DecodeSkippingAlphabet is like DecodeSkipping but uses the passed alphabet.
+func DecodeSkippingAlphabet(str, separators string, alphabet *Alphabet) ([]byte, error) {
+	var skip [128]bool
+	for _, c := range " \t\n\v\f\r" + separators {
+		if c < 128 {
+			skip[c] = true
+		}
+	}
+
+	buf := make([]byte, 0, len(str))
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		switch {
+		case c >= 128:
+			return nil, CorruptInputError(i)
+		case alphabet.decode[c] != -1:
+			buf = append(buf, c)
+		case !skip[c]:
+			return nil, CorruptInputError(i)
+		}
+	}
+	if len(buf) == len(str) {
+		return FastBase58DecodingAlphabet(str, alphabet)
+	}
+	return FastBase58DecodingAlphabet(string(buf), alphabet)
+}
@@ -0,0 +1,58 @@
+package base58
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDecodeSkipping(t *testing.T) {
+	want := []byte("Hello World!")
+	tests := []struct {
+		in, sep string
+	}{
+		{"2NEpo7TZRRrLZSi2U", ""},
+		{"2NEpo7TZRR\nrLZSi2U\n", ""},
+		{" \t2NEpo7\r\n TZRRrL\vZSi2U\f", ""},
+		{"2NEp-o7TZ-RRrL-ZSi2U", "-"},
+		{"2NEp o7TZ.RRrL ZSi2U", "."},
+	}
+	for _, tt := range tests {
+		got, err := DecodeSkipping(tt.in, tt.sep)
+		if err != nil {
+			t.Errorf("%q: %v", tt.in, err)
+			continue
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%q: got %q", tt.in, got)
+		}
+	}
+}
+
+func TestDecodeSkippingErrors(t *testing.T) {
+	tests := []struct {
+		in, sep string
+		off     int64
+	}{
+		{"2NEp-o7TZ", "", 4},
+		{"2NEp o7TZ0", "", 9},
+		{"2NE\n\xffpo", "", 4},
+		{"2NE:po", "-", 3},
+	}
+	for _, tt := range tests {
+		_, err := DecodeSkipping(tt.in, tt.sep)
+		if e, ok := err.(CorruptInputError); !ok || int64(e) != tt.off {
+			t.Errorf("%q: got %v, want offset %d", tt.in, err, tt.off)
+		}
+	}
+	if _, err := DecodeSkipping(" \n ", ""); err == nil {
+		t.Error("expected an error for blank input")
+	}
+}
+
+func TestDecodeSkippingDigitSeparator(t *testing.T) {
+	// '1' is a digit, so it must not be dropped even when listed.
+	got, err := DecodeSkipping("11", "1")
+	if err != nil || !bytes.Equal(got, []byte{0, 0}) {
+		t.Errorf("got %v, %v", got, err)
+	}
+}
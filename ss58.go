@@ -0,0 +1,174 @@
+package base58
+
+import (
+	"errors"
+
+	"github.com/mr-tron/base58/internal/blake2b"
+)
+
+var (
+	// ErrSS58Prefix is returned for network identifiers that cannot be
+	// represented in SS58, and for addresses using a reserved prefix.
+	ErrSS58Prefix = errors.New("invalid ss58 network prefix")
+	// ErrSS58Length is returned when the payload length does not match any
+	// SS58 address type.
+	ErrSS58Length = errors.New("invalid ss58 payload length")
+)
+
+var ss58Pre = []byte("SS58PRE")
+
+// ss58Networks maps well-known network identifiers to their names, as listed
+// in the SS58 registry.
+var ss58Networks = map[uint16]string{
+	0:    "polkadot",
+	2:    "kusama",
+	5:    "astar",
+	7:    "edgeware",
+	42:   "substrate",
+	1284: "moonbeam",
+	1285: "moonriver",
+}
+
+// SS58Address is a decoded SS58 address.
+type SS58Address struct {
+	// Prefix is the network identifier.
+	Prefix uint16
+	// Payload is the account identifier, usually a 32 byte public key.
+	Payload []byte
+}
+
+// Network returns the name of the network the address belongs to, or the
+// empty string if the prefix is not a well-known one.
+func (a *SS58Address) Network() string {
+	return ss58Networks[a.Prefix]
+}
+
+// SS58NetworkName returns the name of the network using the passed SS58
+// prefix.
+func SS58NetworkName(prefix uint16) (string, bool) {
+	name, ok := ss58Networks[prefix]
+	return name, ok
+}
+
+// SS58Encode encodes the payload as an SS58 address for the network with
+// the passed prefix. SS58 is the address format of Polkadot, Kusama and other
+// Substrate based chains:
+//
+//	base58(prefix || payload || blake2b-512("SS58PRE" || prefix || payload)[:n])
+//
+// The prefix takes one byte below 64 and two bytes up to 16383. The checksum
+// is two bytes long for 32 and 33 byte payloads (public keys) and one byte
+// long for 1, 2, 4 and 8 byte payloads (account indices).
+func SS58Encode(prefix uint16, payload []byte) (string, error) {
+	pre, err := ss58PrefixBytes(prefix)
+	if err != nil {
+		return "", err
+	}
+	ck := ss58ChecksumLen(len(payload))
+	if ck == 0 {
+		return "", ErrSS58Length
+	}
+
+	buf := make([]byte, 0, len(pre)+len(payload)+ck)
+	buf = append(buf, pre...)
+	buf = append(buf, payload...)
+	sum := ss58Checksum(buf)
+	buf = append(buf, sum[:ck]...)
+
+	return Encode(buf), nil
+}
+
+// SS58Decode decodes an SS58 address and verifies its checksum.
+func SS58Decode(addr string) (*SS58Address, error) {
+	data, err := Decode(addr)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, ErrSS58Length
+	}
+
+	var prefix uint16
+	var preLen int
+	switch {
+	case data[0] < 64:
+		prefix, preLen = uint16(data[0]), 1
+	case data[0] < 128:
+		if len(data) < 2 {
+			return nil, ErrSS58Length
+		}
+		lower := data[0]<<2 | data[1]>>6
+		upper := data[1] & 0x3f
+		prefix, preLen = uint16(lower)|uint16(upper)<<8, 2
+	default:
+		return nil, ErrSS58Prefix
+	}
+	if ss58Reserved(prefix) {
+		return nil, ErrSS58Prefix
+	}
+
+	// The checksum length depends on the payload length, which in turn is
+	// only known once the checksum length is; try the two possibilities.
+	rest := len(data) - preLen
+	ck := 0
+	for _, n := range []int{2, 1} {
+		if rest > n && ss58ChecksumLen(rest-n) == n {
+			ck = n
+			break
+		}
+	}
+	if ck == 0 {
+		return nil, ErrSS58Length
+	}
+
+	body := data[:len(data)-ck]
+	sum := ss58Checksum(body)
+	for i := 0; i < ck; i++ {
+		if sum[i] != data[len(body)+i] {
+			return nil, ErrChecksum
+		}
+	}
+
+	return &SS58Address{Prefix: prefix, Payload: body[preLen:]}, nil
+}
+
+func ss58PrefixBytes(prefix uint16) ([]byte, error) {
+	switch {
+	case ss58Reserved(prefix):
+		return nil, ErrSS58Prefix
+	case prefix < 64:
+		return []byte{byte(prefix)}, nil
+	case prefix < 16384:
+		first := byte(prefix&0xfc)>>2 | 0x40
+		second := byte(prefix>>8) | byte(prefix&0x03)<<6
+		return []byte{first, second}, nil
+	default:
+		return nil, ErrSS58Prefix
+	}
+}
+
+// ss58Reserved reports whether the prefix is reserved by the SS58 spec.
+func ss58Reserved(prefix uint16) bool {
+	return prefix == 46 || prefix == 47
+}
+
+// ss58ChecksumLen returns the checksum length used for a payload of n bytes,
+// or 0 if no SS58 address type has such a payload.
+func ss58ChecksumLen(n int) int {
+	switch n {
+	case 32, 33:
+		return 2
+	case 1, 2, 4, 8:
+		return 1
+	}
+	return 0
+}
+
+func ss58Checksum(body []byte) [blake2b.Size]byte {
+	h := blake2b.New512()
+	h.Write(ss58Pre)
+	h.Write(body)
+	var sum [blake2b.Size]byte
+	h.Sum(sum[:0])
+	return sum
+}
@@ -0,0 +1,72 @@
+package base58
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+// Alice's development key.
+var ss58AliceKey, _ = hex.DecodeString("d43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d")
+
+func TestSS58Known(t *testing.T) {
+	const addr = "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY"
+
+	enc, err := SS58Encode(42, ss58AliceKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enc != addr {
+		t.Errorf("SS58Encode = %s, want %s", enc, addr)
+	}
+
+	dec, err := SS58Decode(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dec.Prefix != 42 || !bytes.Equal(dec.Payload, ss58AliceKey) {
+		t.Errorf("SS58Decode = %d %x", dec.Prefix, dec.Payload)
+	}
+	if dec.Network() != "substrate" {
+		t.Errorf("Network() = %q, want substrate", dec.Network())
+	}
+}
+
+func TestSS58RoundTrip(t *testing.T) {
+	prefixes := []uint16{0, 2, 42, 63, 64, 255, 1284, 16383}
+	for _, prefix := range prefixes {
+		for _, n := range []int{1, 2, 4, 8, 32, 33} {
+			payload := bytes.Repeat([]byte{0xa5}, n)
+			enc, err := SS58Encode(prefix, payload)
+			if err != nil {
+				t.Fatalf("prefix %d len %d: %v", prefix, n, err)
+			}
+			dec, err := SS58Decode(enc)
+			if err != nil {
+				t.Fatalf("prefix %d len %d: %v", prefix, n, err)
+			}
+			if dec.Prefix != prefix || !bytes.Equal(dec.Payload, payload) {
+				t.Errorf("prefix %d len %d: got %d %x", prefix, n, dec.Prefix, dec.Payload)
+			}
+		}
+	}
+}
+
+func TestSS58Errors(t *testing.T) {
+	if _, err := SS58Encode(16384, ss58AliceKey); err != ErrSS58Prefix {
+		t.Errorf("prefix 16384: got %v", err)
+	}
+	if _, err := SS58Encode(46, ss58AliceKey); err != ErrSS58Prefix {
+		t.Errorf("prefix 46: got %v", err)
+	}
+	if _, err := SS58Encode(0, ss58AliceKey[:20]); err != ErrSS58Length {
+		t.Errorf("20 byte payload: got %v", err)
+	}
+
+	enc, _ := SS58Encode(0, ss58AliceKey)
+	raw, _ := Decode(enc)
+	raw[len(raw)-1] ^= 1
+	if _, err := SS58Decode(Encode(raw)); err != ErrChecksum {
+		t.Errorf("corrupted checksum: got %v", err)
+	}
+}
@@ -0,0 +1,166 @@
+package base58
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// StreamBlockSize is the number of input bytes per line of a stream.
+const StreamBlockSize = 64
+
+// ErrStreamBlock is returned when a stream holds an empty block, a block
+// longer than StreamBlockSize, or a short block that is not the last one.
+var ErrStreamBlock = errors.New("invalid block in base58 stream")
+
+// maxStreamLine is the longest encoded block plus its newline.
+const maxStreamLine = StreamBlockSize*555/406 + 2
+
+// NewEncoder returns a stream encoder writing to w. Base58 has no natural
+// block boundaries, so the input is cut into blocks of StreamBlockSize bytes
+// and every block is encoded on its own line:
+//
+//	base58(block 1) "\n" base58(block 2) "\n" ... base58(last block) "\n"
+//
+// Leading zero bytes survive encoding, so each line decodes to exactly the
+// bytes of its block. Only the last block may be shorter, an empty input
+// produces no lines, and a line is at most 88 characters long. The caller
+// must Close the writer to flush the last partial block. Closing does not
+// close w.
+func NewEncoder(w io.Writer) io.WriteCloser {
+	return NewEncoderAlphabet(w, BTCAlphabet)
+}
+
+// NewEncoderAlphabet is like NewEncoder but uses the passed alphabet, which
+// must not contain '\n'.
+func NewEncoderAlphabet(w io.Writer, alphabet *Alphabet) io.WriteCloser {
+	return &streamEncoder{w: w, codec: NewCodec(alphabet)}
+}
+
+type streamEncoder struct {
+	w     io.Writer
+	codec *Codec
+	block [StreamBlockSize]byte
+	n     int
+	line  []byte
+	err   error
+}
+
+func (e *streamEncoder) Write(p []byte) (int, error) {
+	if e.err != nil {
+		return 0, e.err
+	}
+	written := 0
+	for len(p) > 0 {
+		c := copy(e.block[e.n:], p)
+		e.n += c
+		p = p[c:]
+		if e.n == StreamBlockSize {
+			if e.err = e.flush(); e.err != nil {
+				return written, e.err
+			}
+		}
+		written += c
+	}
+	return written, nil
+}
+
+// Close flushes any buffered data as the last block.
+func (e *streamEncoder) Close() error {
+	if e.err == nil && e.n > 0 {
+		e.err = e.flush()
+	}
+	return e.err
+}
+
+func (e *streamEncoder) flush() error {
+	e.line = e.codec.AppendEncode(e.line[:0], e.block[:e.n])
+	e.line = append(e.line, '\n')
+	e.n = 0
+	_, err := e.w.Write(e.line)
+	return err
+}
+
+// NewDecoder returns a stream decoder reading the format written by
+// NewEncoder from r. Lines may end in CRLF, and the final newline is
+// optional.
+func NewDecoder(r io.Reader) io.Reader {
+	return NewDecoderAlphabet(r, BTCAlphabet)
+}
+
+// NewDecoderAlphabet is like NewDecoder but uses the passed alphabet.
+func NewDecoderAlphabet(r io.Reader, alphabet *Alphabet) io.Reader {
+	d := &streamDecoder{r: bufio.NewReader(r), codec: NewCodec(alphabet)}
+	d.lines = d.readLine
+	return d
+}
+
+type streamDecoder struct {
+	r     *bufio.Reader
+	lines func() ([]byte, error) // source of encoded blocks
+	codec *Codec
+	block []byte
+	out   []byte // undelivered part of block
+	line  int
+	short bool // the previous block was short, so it must be the last
+	err   error
+}
+
+func (d *streamDecoder) Read(p []byte) (int, error) {
+	for len(d.out) == 0 {
+		if d.err != nil {
+			return 0, d.err
+		}
+		d.err = d.next()
+	}
+	n := copy(p, d.out)
+	d.out = d.out[n:]
+	return n, nil
+}
+
+// next decodes the next line into d.out.
+func (d *streamDecoder) next() error {
+	line, err := d.lines()
+	if err != nil {
+		return err
+	}
+	d.line++
+	if d.short {
+		return fmt.Errorf("line %d: %v", d.line, ErrStreamBlock)
+	}
+
+	d.block, err = d.codec.AppendDecode(d.block[:0], string(line))
+	if err != nil {
+		return fmt.Errorf("line %d: %v", d.line, err)
+	}
+	if len(d.block) > StreamBlockSize {
+		return fmt.Errorf("line %d: %v", d.line, ErrStreamBlock)
+	}
+	d.short = len(d.block) < StreamBlockSize
+	d.out = d.block
+	return nil
+}
+
+// readLine returns the next line without its LF or CRLF ending, or io.EOF at
+// the end of the stream.
+func (d *streamDecoder) readLine() ([]byte, error) {
+	line, err := d.r.ReadSlice('\n')
+	switch {
+	case err == bufio.ErrBufferFull:
+		return nil, fmt.Errorf("line %d: %v", d.line+1, ErrStreamBlock)
+	case err == io.EOF && len(line) > 0:
+		// The final newline is optional.
+	case err != nil:
+		return nil, err
+	default:
+		line = line[:len(line)-1]
+	}
+	if n := len(line); n > 0 && line[n-1] == '\r' {
+		line = line[:n-1]
+	}
+	if len(line) == 0 || len(line) >= maxStreamLine {
+		return nil, fmt.Errorf("line %d: %v", d.line+1, ErrStreamBlock)
+	}
+	return line, nil
+}
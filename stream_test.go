@@ -0,0 +1,99 @@
+package base58
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestStreamRoundTrip(t *testing.T) {
+	sizes := []int{0, 1, StreamBlockSize - 1, StreamBlockSize, StreamBlockSize + 1, 10*StreamBlockSize + 17}
+	for _, alph := range []*Alphabet{BTCAlphabet, FlickrAlphabet} {
+		for _, size := range sizes {
+			data := make([]byte, size)
+			rand.Read(data)
+			// Zero runs across block boundaries must survive.
+			for i := 0; i < size && i < StreamBlockSize+5; i++ {
+				data[i] = 0
+			}
+
+			var buf bytes.Buffer
+			enc := NewEncoderAlphabet(&buf, alph)
+			// Write in uneven pieces.
+			for p := data; len(p) > 0; {
+				n := 1 + rand.Intn(100)
+				if n > len(p) {
+					n = len(p)
+				}
+				if _, err := enc.Write(p[:n]); err != nil {
+					t.Fatal(err)
+				}
+				p = p[n:]
+			}
+			if err := enc.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			lines := strings.Count(buf.String(), "\n")
+			if want := (size + StreamBlockSize - 1) / StreamBlockSize; lines != want {
+				t.Errorf("size %d: %d lines, want %d", size, lines, want)
+			}
+
+			got, err := ioutil.ReadAll(iotest.OneByteReader(NewDecoderAlphabet(&buf, alph)))
+			if err != nil {
+				t.Fatalf("size %d: %v", size, err)
+			}
+			if !bytes.Equal(got, data) {
+				t.Fatalf("size %d: stream did not round trip", size)
+			}
+		}
+	}
+}
+
+func TestStreamMissingFinalNewline(t *testing.T) {
+	got, err := ioutil.ReadAll(NewDecoder(strings.NewReader("2NEpo7TZRRrLZSi2U")))
+	if err != nil || string(got) != "Hello World!" {
+		t.Errorf("got %q, %v", got, err)
+	}
+}
+
+func TestStreamCRLF(t *testing.T) {
+	data := make([]byte, 3*StreamBlockSize+5)
+	rand.Read(data)
+	var buf bytes.Buffer
+	w := NewEncoder(&buf)
+	w.Write(data)
+	w.Close()
+	crlf := strings.Replace(buf.String(), "\n", "\r\n", -1)
+
+	for _, in := range []string{crlf, strings.TrimSuffix(crlf, "\n")} {
+		got, err := ioutil.ReadAll(NewDecoder(strings.NewReader(in)))
+		if err != nil || !bytes.Equal(got, data) {
+			t.Errorf("%.20q...: got %x, %v", in, got, err)
+		}
+	}
+}
+
+func TestStreamErrors(t *testing.T) {
+	full := Encode(bytes.Repeat([]byte{1}, StreamBlockSize))
+	short := Encode([]byte("abc"))
+	long := Encode(bytes.Repeat([]byte{1}, StreamBlockSize+1))
+
+	tests := []string{
+		short + "\n" + full + "\n", // short block before the end
+		full + "\n\n" + short + "\n",
+		long + "\n",
+		full + "\n0OIl\n",
+		strings.Repeat("z", 5000) + "\n",
+	}
+	for _, in := range tests {
+		_, err := ioutil.ReadAll(NewDecoder(strings.NewReader(in)))
+		if err == nil || err == io.EOF {
+			t.Errorf("%.20q...: expected an error", in)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package base58
+
+// decodeUnrolled decodes str, which must satisfy unrolledDecodable.
+func decodeUnrolled(str string, alphabet *Alphabet) ([]byte, error) {
+	return appendDecodeUnrolled(nil, str, alphabet)
+}
+
+// appendUnrolledBytes appends zcount zero bytes followed by the big-endian
+// value of the limbs without its leading zero bytes to dst.
+func appendUnrolledBytes(dst []byte, z []uint32, zcount int) []byte {
+	var buf [4 * maxUnrolledLimbs]byte
+	for i, x := range z {
+		buf[4*i] = byte(x >> 24)
+		buf[4*i+1] = byte(x >> 16)
+		buf[4*i+2] = byte(x >> 8)
+		buf[4*i+3] = byte(x)
+	}
+	i := 0
+	for i < len(buf) && buf[i] == 0 {
+		i++
+	}
+
+	n := zcount + len(buf) - i
+	if cap(dst)-len(dst) < n {
+		grown := make([]byte, len(dst), len(dst)+n)
+		copy(grown, dst)
+		dst = grown
+	}
+	for j := 0; j < zcount; j++ {
+		dst = append(dst, 0)
+	}
+	return append(dst, buf[i:]...)
+}
@@ -0,0 +1,3241 @@
+// Code generated by go run gen_unrolled.go -lengths 25,32,33,34,64,82 -alphabets BTCAlphabet,FlickrAlphabet; DO NOT EDIT.
+
+package base58
+
+// encodeUnrolled encodes bin with an unrolled encoder if one was generated
+// for its length and alphabet.
+func encodeUnrolled(bin []byte, alphabet *Alphabet) (string, bool) {
+	switch alphabet {
+	case BTCAlphabet:
+		switch len(bin) {
+		case 25:
+			return encodeUnrolled25BTC(bin), true
+		case 32:
+			return encodeUnrolled32BTC(bin), true
+		case 33:
+			return encodeUnrolled33BTC(bin), true
+		case 34:
+			return encodeUnrolled34BTC(bin), true
+		case 64:
+			return encodeUnrolled64BTC(bin), true
+		case 82:
+			return encodeUnrolled82BTC(bin), true
+		}
+	case FlickrAlphabet:
+		switch len(bin) {
+		case 25:
+			return encodeUnrolled25Flickr(bin), true
+		case 32:
+			return encodeUnrolled32Flickr(bin), true
+		case 33:
+			return encodeUnrolled33Flickr(bin), true
+		case 34:
+			return encodeUnrolled34Flickr(bin), true
+		case 64:
+			return encodeUnrolled64Flickr(bin), true
+		case 82:
+			return encodeUnrolled82Flickr(bin), true
+		}
+	}
+	return "", false
+}
+
+// appendEncodeUnrolled is like encodeUnrolled but appends the encoding to
+// dst.
+func appendEncodeUnrolled(dst, bin []byte, alphabet *Alphabet) ([]byte, bool) {
+	if alphabet != BTCAlphabet && alphabet != FlickrAlphabet {
+		return dst, false
+	}
+	var digits []byte
+	switch len(bin) {
+	case 25:
+		var buf [35]byte
+		digits = buf[encodeDigits25(bin, &buf):]
+	case 32:
+		var buf [45]byte
+		digits = buf[encodeDigits32(bin, &buf):]
+	case 33:
+		var buf [50]byte
+		digits = buf[encodeDigits33(bin, &buf):]
+	case 34:
+		var buf [50]byte
+		digits = buf[encodeDigits34(bin, &buf):]
+	case 64:
+		var buf [90]byte
+		digits = buf[encodeDigits64(bin, &buf):]
+	case 82:
+		var buf [115]byte
+		digits = buf[encodeDigits82(bin, &buf):]
+	default:
+		return dst, false
+	}
+	start := len(dst)
+	dst = append(dst, digits...)
+	for j := start; j < len(dst); j++ {
+		dst[j] = alphabet.encode[dst[j]]
+	}
+	return dst, true
+}
+
+// encodeDigits25 writes the base58 digit values of the 25 byte src to the
+// end of buf and returns the start of the encoding, which includes one zero
+// digit per leading zero byte.
+func encodeDigits25(src []byte, buf *[35]byte) int {
+	_ = src[24]
+	x0 := uint32(src[0])
+	x1 := uint32(src[1])<<24 | uint32(src[2])<<16 | uint32(src[3])<<8 | uint32(src[4])
+	x2 := uint32(src[5])<<24 | uint32(src[6])<<16 | uint32(src[7])<<8 | uint32(src[8])
+	x3 := uint32(src[9])<<24 | uint32(src[10])<<16 | uint32(src[11])<<8 | uint32(src[12])
+	x4 := uint32(src[13])<<24 | uint32(src[14])<<16 | uint32(src[15])<<8 | uint32(src[16])
+	x5 := uint32(src[17])<<24 | uint32(src[18])<<16 | uint32(src[19])<<8 | uint32(src[20])
+	x6 := uint32(src[21])<<24 | uint32(src[22])<<16 | uint32(src[23])<<8 | uint32(src[24])
+	var r, t uint64
+
+	// digits 0 to 4
+	r = 0
+	t = r<<32 | uint64(x0)
+	x0 = uint32(t / 656356768)
+	r = t - uint64(x0)*656356768
+	t = r<<32 | uint64(x1)
+	x1 = uint32(t / 656356768)
+	r = t - uint64(x1)*656356768
+	t = r<<32 | uint64(x2)
+	x2 = uint32(t / 656356768)
+	r = t - uint64(x2)*656356768
+	t = r<<32 | uint64(x3)
+	x3 = uint32(t / 656356768)
+	r = t - uint64(x3)*656356768
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	buf[34] = byte(r % 58)
+	r /= 58
+	buf[33] = byte(r % 58)
+	r /= 58
+	buf[32] = byte(r % 58)
+	r /= 58
+	buf[31] = byte(r % 58)
+	r /= 58
+	buf[30] = byte(r)
+
+	// digits 5 to 9
+	r = 0
+	t = r<<32 | uint64(x1)
+	x1 = uint32(t / 656356768)
+	r = t - uint64(x1)*656356768
+	t = r<<32 | uint64(x2)
+	x2 = uint32(t / 656356768)
+	r = t - uint64(x2)*656356768
+	t = r<<32 | uint64(x3)
+	x3 = uint32(t / 656356768)
+	r = t - uint64(x3)*656356768
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	buf[29] = byte(r % 58)
+	r /= 58
+	buf[28] = byte(r % 58)
+	r /= 58
+	buf[27] = byte(r % 58)
+	r /= 58
+	buf[26] = byte(r % 58)
+	r /= 58
+	buf[25] = byte(r)
+
+	// digits 10 to 14
+	r = 0
+	t = r<<32 | uint64(x2)
+	x2 = uint32(t / 656356768)
+	r = t - uint64(x2)*656356768
+	t = r<<32 | uint64(x3)
+	x3 = uint32(t / 656356768)
+	r = t - uint64(x3)*656356768
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	buf[24] = byte(r % 58)
+	r /= 58
+	buf[23] = byte(r % 58)
+	r /= 58
+	buf[22] = byte(r % 58)
+	r /= 58
+	buf[21] = byte(r % 58)
+	r /= 58
+	buf[20] = byte(r)
+
+	// digits 15 to 19
+	r = 0
+	t = r<<32 | uint64(x3)
+	x3 = uint32(t / 656356768)
+	r = t - uint64(x3)*656356768
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	buf[19] = byte(r % 58)
+	r /= 58
+	buf[18] = byte(r % 58)
+	r /= 58
+	buf[17] = byte(r % 58)
+	r /= 58
+	buf[16] = byte(r % 58)
+	r /= 58
+	buf[15] = byte(r)
+
+	// digits 20 to 24
+	r = 0
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	buf[14] = byte(r % 58)
+	r /= 58
+	buf[13] = byte(r % 58)
+	r /= 58
+	buf[12] = byte(r % 58)
+	r /= 58
+	buf[11] = byte(r % 58)
+	r /= 58
+	buf[10] = byte(r)
+
+	// digits 25 to 29
+	r = 0
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	buf[9] = byte(r % 58)
+	r /= 58
+	buf[8] = byte(r % 58)
+	r /= 58
+	buf[7] = byte(r % 58)
+	r /= 58
+	buf[6] = byte(r % 58)
+	r /= 58
+	buf[5] = byte(r)
+
+	// digits 30 to 34
+	r = 0
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	buf[4] = byte(r % 58)
+	r /= 58
+	buf[3] = byte(r % 58)
+	r /= 58
+	buf[2] = byte(r % 58)
+	r /= 58
+	buf[1] = byte(r % 58)
+	r /= 58
+	buf[0] = byte(r)
+
+	i := 0
+	for i < len(buf) && buf[i] == 0 {
+		i++
+	}
+	for z := 0; z < 25 && src[z] == 0; z++ {
+		i--
+	}
+	return i
+}
+
+func encodeUnrolled25BTC(src []byte) string {
+	const chars = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	var buf [35]byte
+	i := encodeDigits25(src, &buf)
+	for j := i; j < len(buf); j++ {
+		buf[j] = chars[buf[j]]
+	}
+	return string(buf[i:])
+}
+
+func encodeUnrolled25Flickr(src []byte) string {
+	const chars = "123456789abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ"
+	var buf [35]byte
+	i := encodeDigits25(src, &buf)
+	for j := i; j < len(buf); j++ {
+		buf[j] = chars[buf[j]]
+	}
+	return string(buf[i:])
+}
+
+// encodeDigits32 writes the base58 digit values of the 32 byte src to the
+// end of buf and returns the start of the encoding, which includes one zero
+// digit per leading zero byte.
+func encodeDigits32(src []byte, buf *[45]byte) int {
+	_ = src[31]
+	x0 := uint32(src[0])<<24 | uint32(src[1])<<16 | uint32(src[2])<<8 | uint32(src[3])
+	x1 := uint32(src[4])<<24 | uint32(src[5])<<16 | uint32(src[6])<<8 | uint32(src[7])
+	x2 := uint32(src[8])<<24 | uint32(src[9])<<16 | uint32(src[10])<<8 | uint32(src[11])
+	x3 := uint32(src[12])<<24 | uint32(src[13])<<16 | uint32(src[14])<<8 | uint32(src[15])
+	x4 := uint32(src[16])<<24 | uint32(src[17])<<16 | uint32(src[18])<<8 | uint32(src[19])
+	x5 := uint32(src[20])<<24 | uint32(src[21])<<16 | uint32(src[22])<<8 | uint32(src[23])
+	x6 := uint32(src[24])<<24 | uint32(src[25])<<16 | uint32(src[26])<<8 | uint32(src[27])
+	x7 := uint32(src[28])<<24 | uint32(src[29])<<16 | uint32(src[30])<<8 | uint32(src[31])
+	var r, t uint64
+
+	// digits 0 to 4
+	r = 0
+	t = r<<32 | uint64(x0)
+	x0 = uint32(t / 656356768)
+	r = t - uint64(x0)*656356768
+	t = r<<32 | uint64(x1)
+	x1 = uint32(t / 656356768)
+	r = t - uint64(x1)*656356768
+	t = r<<32 | uint64(x2)
+	x2 = uint32(t / 656356768)
+	r = t - uint64(x2)*656356768
+	t = r<<32 | uint64(x3)
+	x3 = uint32(t / 656356768)
+	r = t - uint64(x3)*656356768
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	buf[44] = byte(r % 58)
+	r /= 58
+	buf[43] = byte(r % 58)
+	r /= 58
+	buf[42] = byte(r % 58)
+	r /= 58
+	buf[41] = byte(r % 58)
+	r /= 58
+	buf[40] = byte(r)
+
+	// digits 5 to 9
+	r = 0
+	t = r<<32 | uint64(x0)
+	x0 = uint32(t / 656356768)
+	r = t - uint64(x0)*656356768
+	t = r<<32 | uint64(x1)
+	x1 = uint32(t / 656356768)
+	r = t - uint64(x1)*656356768
+	t = r<<32 | uint64(x2)
+	x2 = uint32(t / 656356768)
+	r = t - uint64(x2)*656356768
+	t = r<<32 | uint64(x3)
+	x3 = uint32(t / 656356768)
+	r = t - uint64(x3)*656356768
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	buf[39] = byte(r % 58)
+	r /= 58
+	buf[38] = byte(r % 58)
+	r /= 58
+	buf[37] = byte(r % 58)
+	r /= 58
+	buf[36] = byte(r % 58)
+	r /= 58
+	buf[35] = byte(r)
+
+	// digits 10 to 14
+	r = 0
+	t = r<<32 | uint64(x1)
+	x1 = uint32(t / 656356768)
+	r = t - uint64(x1)*656356768
+	t = r<<32 | uint64(x2)
+	x2 = uint32(t / 656356768)
+	r = t - uint64(x2)*656356768
+	t = r<<32 | uint64(x3)
+	x3 = uint32(t / 656356768)
+	r = t - uint64(x3)*656356768
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	buf[34] = byte(r % 58)
+	r /= 58
+	buf[33] = byte(r % 58)
+	r /= 58
+	buf[32] = byte(r % 58)
+	r /= 58
+	buf[31] = byte(r % 58)
+	r /= 58
+	buf[30] = byte(r)
+
+	// digits 15 to 19
+	r = 0
+	t = r<<32 | uint64(x2)
+	x2 = uint32(t / 656356768)
+	r = t - uint64(x2)*656356768
+	t = r<<32 | uint64(x3)
+	x3 = uint32(t / 656356768)
+	r = t - uint64(x3)*656356768
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	buf[29] = byte(r % 58)
+	r /= 58
+	buf[28] = byte(r % 58)
+	r /= 58
+	buf[27] = byte(r % 58)
+	r /= 58
+	buf[26] = byte(r % 58)
+	r /= 58
+	buf[25] = byte(r)
+
+	// digits 20 to 24
+	r = 0
+	t = r<<32 | uint64(x3)
+	x3 = uint32(t / 656356768)
+	r = t - uint64(x3)*656356768
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	buf[24] = byte(r % 58)
+	r /= 58
+	buf[23] = byte(r % 58)
+	r /= 58
+	buf[22] = byte(r % 58)
+	r /= 58
+	buf[21] = byte(r % 58)
+	r /= 58
+	buf[20] = byte(r)
+
+	// digits 25 to 29
+	r = 0
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	buf[19] = byte(r % 58)
+	r /= 58
+	buf[18] = byte(r % 58)
+	r /= 58
+	buf[17] = byte(r % 58)
+	r /= 58
+	buf[16] = byte(r % 58)
+	r /= 58
+	buf[15] = byte(r)
+
+	// digits 30 to 34
+	r = 0
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	buf[14] = byte(r % 58)
+	r /= 58
+	buf[13] = byte(r % 58)
+	r /= 58
+	buf[12] = byte(r % 58)
+	r /= 58
+	buf[11] = byte(r % 58)
+	r /= 58
+	buf[10] = byte(r)
+
+	// digits 35 to 39
+	r = 0
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	buf[9] = byte(r % 58)
+	r /= 58
+	buf[8] = byte(r % 58)
+	r /= 58
+	buf[7] = byte(r % 58)
+	r /= 58
+	buf[6] = byte(r % 58)
+	r /= 58
+	buf[5] = byte(r)
+
+	// digits 40 to 44
+	r = 0
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	buf[4] = byte(r % 58)
+	r /= 58
+	buf[3] = byte(r % 58)
+	r /= 58
+	buf[2] = byte(r % 58)
+	r /= 58
+	buf[1] = byte(r % 58)
+	r /= 58
+	buf[0] = byte(r)
+
+	i := 0
+	for i < len(buf) && buf[i] == 0 {
+		i++
+	}
+	for z := 0; z < 32 && src[z] == 0; z++ {
+		i--
+	}
+	return i
+}
+
+func encodeUnrolled32BTC(src []byte) string {
+	const chars = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	var buf [45]byte
+	i := encodeDigits32(src, &buf)
+	for j := i; j < len(buf); j++ {
+		buf[j] = chars[buf[j]]
+	}
+	return string(buf[i:])
+}
+
+func encodeUnrolled32Flickr(src []byte) string {
+	const chars = "123456789abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ"
+	var buf [45]byte
+	i := encodeDigits32(src, &buf)
+	for j := i; j < len(buf); j++ {
+		buf[j] = chars[buf[j]]
+	}
+	return string(buf[i:])
+}
+
+// encodeDigits33 writes the base58 digit values of the 33 byte src to the
+// end of buf and returns the start of the encoding, which includes one zero
+// digit per leading zero byte.
+func encodeDigits33(src []byte, buf *[50]byte) int {
+	_ = src[32]
+	x0 := uint32(src[0])
+	x1 := uint32(src[1])<<24 | uint32(src[2])<<16 | uint32(src[3])<<8 | uint32(src[4])
+	x2 := uint32(src[5])<<24 | uint32(src[6])<<16 | uint32(src[7])<<8 | uint32(src[8])
+	x3 := uint32(src[9])<<24 | uint32(src[10])<<16 | uint32(src[11])<<8 | uint32(src[12])
+	x4 := uint32(src[13])<<24 | uint32(src[14])<<16 | uint32(src[15])<<8 | uint32(src[16])
+	x5 := uint32(src[17])<<24 | uint32(src[18])<<16 | uint32(src[19])<<8 | uint32(src[20])
+	x6 := uint32(src[21])<<24 | uint32(src[22])<<16 | uint32(src[23])<<8 | uint32(src[24])
+	x7 := uint32(src[25])<<24 | uint32(src[26])<<16 | uint32(src[27])<<8 | uint32(src[28])
+	x8 := uint32(src[29])<<24 | uint32(src[30])<<16 | uint32(src[31])<<8 | uint32(src[32])
+	var r, t uint64
+
+	// digits 0 to 4
+	r = 0
+	t = r<<32 | uint64(x0)
+	x0 = uint32(t / 656356768)
+	r = t - uint64(x0)*656356768
+	t = r<<32 | uint64(x1)
+	x1 = uint32(t / 656356768)
+	r = t - uint64(x1)*656356768
+	t = r<<32 | uint64(x2)
+	x2 = uint32(t / 656356768)
+	r = t - uint64(x2)*656356768
+	t = r<<32 | uint64(x3)
+	x3 = uint32(t / 656356768)
+	r = t - uint64(x3)*656356768
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	buf[49] = byte(r % 58)
+	r /= 58
+	buf[48] = byte(r % 58)
+	r /= 58
+	buf[47] = byte(r % 58)
+	r /= 58
+	buf[46] = byte(r % 58)
+	r /= 58
+	buf[45] = byte(r)
+
+	// digits 5 to 9
+	r = 0
+	t = r<<32 | uint64(x1)
+	x1 = uint32(t / 656356768)
+	r = t - uint64(x1)*656356768
+	t = r<<32 | uint64(x2)
+	x2 = uint32(t / 656356768)
+	r = t - uint64(x2)*656356768
+	t = r<<32 | uint64(x3)
+	x3 = uint32(t / 656356768)
+	r = t - uint64(x3)*656356768
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	buf[44] = byte(r % 58)
+	r /= 58
+	buf[43] = byte(r % 58)
+	r /= 58
+	buf[42] = byte(r % 58)
+	r /= 58
+	buf[41] = byte(r % 58)
+	r /= 58
+	buf[40] = byte(r)
+
+	// digits 10 to 14
+	r = 0
+	t = r<<32 | uint64(x2)
+	x2 = uint32(t / 656356768)
+	r = t - uint64(x2)*656356768
+	t = r<<32 | uint64(x3)
+	x3 = uint32(t / 656356768)
+	r = t - uint64(x3)*656356768
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	buf[39] = byte(r % 58)
+	r /= 58
+	buf[38] = byte(r % 58)
+	r /= 58
+	buf[37] = byte(r % 58)
+	r /= 58
+	buf[36] = byte(r % 58)
+	r /= 58
+	buf[35] = byte(r)
+
+	// digits 15 to 19
+	r = 0
+	t = r<<32 | uint64(x3)
+	x3 = uint32(t / 656356768)
+	r = t - uint64(x3)*656356768
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	buf[34] = byte(r % 58)
+	r /= 58
+	buf[33] = byte(r % 58)
+	r /= 58
+	buf[32] = byte(r % 58)
+	r /= 58
+	buf[31] = byte(r % 58)
+	r /= 58
+	buf[30] = byte(r)
+
+	// digits 20 to 24
+	r = 0
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	buf[29] = byte(r % 58)
+	r /= 58
+	buf[28] = byte(r % 58)
+	r /= 58
+	buf[27] = byte(r % 58)
+	r /= 58
+	buf[26] = byte(r % 58)
+	r /= 58
+	buf[25] = byte(r)
+
+	// digits 25 to 29
+	r = 0
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	buf[24] = byte(r % 58)
+	r /= 58
+	buf[23] = byte(r % 58)
+	r /= 58
+	buf[22] = byte(r % 58)
+	r /= 58
+	buf[21] = byte(r % 58)
+	r /= 58
+	buf[20] = byte(r)
+
+	// digits 30 to 34
+	r = 0
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	buf[19] = byte(r % 58)
+	r /= 58
+	buf[18] = byte(r % 58)
+	r /= 58
+	buf[17] = byte(r % 58)
+	r /= 58
+	buf[16] = byte(r % 58)
+	r /= 58
+	buf[15] = byte(r)
+
+	// digits 35 to 39
+	r = 0
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	buf[14] = byte(r % 58)
+	r /= 58
+	buf[13] = byte(r % 58)
+	r /= 58
+	buf[12] = byte(r % 58)
+	r /= 58
+	buf[11] = byte(r % 58)
+	r /= 58
+	buf[10] = byte(r)
+
+	// digits 40 to 44
+	r = 0
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	buf[9] = byte(r % 58)
+	r /= 58
+	buf[8] = byte(r % 58)
+	r /= 58
+	buf[7] = byte(r % 58)
+	r /= 58
+	buf[6] = byte(r % 58)
+	r /= 58
+	buf[5] = byte(r)
+
+	// digits 45 to 49
+	r = 0
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	buf[4] = byte(r % 58)
+	r /= 58
+	buf[3] = byte(r % 58)
+	r /= 58
+	buf[2] = byte(r % 58)
+	r /= 58
+	buf[1] = byte(r % 58)
+	r /= 58
+	buf[0] = byte(r)
+
+	i := 0
+	for i < len(buf) && buf[i] == 0 {
+		i++
+	}
+	for z := 0; z < 33 && src[z] == 0; z++ {
+		i--
+	}
+	return i
+}
+
+func encodeUnrolled33BTC(src []byte) string {
+	const chars = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	var buf [50]byte
+	i := encodeDigits33(src, &buf)
+	for j := i; j < len(buf); j++ {
+		buf[j] = chars[buf[j]]
+	}
+	return string(buf[i:])
+}
+
+func encodeUnrolled33Flickr(src []byte) string {
+	const chars = "123456789abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ"
+	var buf [50]byte
+	i := encodeDigits33(src, &buf)
+	for j := i; j < len(buf); j++ {
+		buf[j] = chars[buf[j]]
+	}
+	return string(buf[i:])
+}
+
+// encodeDigits34 writes the base58 digit values of the 34 byte src to the
+// end of buf and returns the start of the encoding, which includes one zero
+// digit per leading zero byte.
+func encodeDigits34(src []byte, buf *[50]byte) int {
+	_ = src[33]
+	x0 := uint32(src[0])<<8 | uint32(src[1])
+	x1 := uint32(src[2])<<24 | uint32(src[3])<<16 | uint32(src[4])<<8 | uint32(src[5])
+	x2 := uint32(src[6])<<24 | uint32(src[7])<<16 | uint32(src[8])<<8 | uint32(src[9])
+	x3 := uint32(src[10])<<24 | uint32(src[11])<<16 | uint32(src[12])<<8 | uint32(src[13])
+	x4 := uint32(src[14])<<24 | uint32(src[15])<<16 | uint32(src[16])<<8 | uint32(src[17])
+	x5 := uint32(src[18])<<24 | uint32(src[19])<<16 | uint32(src[20])<<8 | uint32(src[21])
+	x6 := uint32(src[22])<<24 | uint32(src[23])<<16 | uint32(src[24])<<8 | uint32(src[25])
+	x7 := uint32(src[26])<<24 | uint32(src[27])<<16 | uint32(src[28])<<8 | uint32(src[29])
+	x8 := uint32(src[30])<<24 | uint32(src[31])<<16 | uint32(src[32])<<8 | uint32(src[33])
+	var r, t uint64
+
+	// digits 0 to 4
+	r = 0
+	t = r<<32 | uint64(x0)
+	x0 = uint32(t / 656356768)
+	r = t - uint64(x0)*656356768
+	t = r<<32 | uint64(x1)
+	x1 = uint32(t / 656356768)
+	r = t - uint64(x1)*656356768
+	t = r<<32 | uint64(x2)
+	x2 = uint32(t / 656356768)
+	r = t - uint64(x2)*656356768
+	t = r<<32 | uint64(x3)
+	x3 = uint32(t / 656356768)
+	r = t - uint64(x3)*656356768
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	buf[49] = byte(r % 58)
+	r /= 58
+	buf[48] = byte(r % 58)
+	r /= 58
+	buf[47] = byte(r % 58)
+	r /= 58
+	buf[46] = byte(r % 58)
+	r /= 58
+	buf[45] = byte(r)
+
+	// digits 5 to 9
+	r = 0
+	t = r<<32 | uint64(x1)
+	x1 = uint32(t / 656356768)
+	r = t - uint64(x1)*656356768
+	t = r<<32 | uint64(x2)
+	x2 = uint32(t / 656356768)
+	r = t - uint64(x2)*656356768
+	t = r<<32 | uint64(x3)
+	x3 = uint32(t / 656356768)
+	r = t - uint64(x3)*656356768
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	buf[44] = byte(r % 58)
+	r /= 58
+	buf[43] = byte(r % 58)
+	r /= 58
+	buf[42] = byte(r % 58)
+	r /= 58
+	buf[41] = byte(r % 58)
+	r /= 58
+	buf[40] = byte(r)
+
+	// digits 10 to 14
+	r = 0
+	t = r<<32 | uint64(x2)
+	x2 = uint32(t / 656356768)
+	r = t - uint64(x2)*656356768
+	t = r<<32 | uint64(x3)
+	x3 = uint32(t / 656356768)
+	r = t - uint64(x3)*656356768
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	buf[39] = byte(r % 58)
+	r /= 58
+	buf[38] = byte(r % 58)
+	r /= 58
+	buf[37] = byte(r % 58)
+	r /= 58
+	buf[36] = byte(r % 58)
+	r /= 58
+	buf[35] = byte(r)
+
+	// digits 15 to 19
+	r = 0
+	t = r<<32 | uint64(x3)
+	x3 = uint32(t / 656356768)
+	r = t - uint64(x3)*656356768
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	buf[34] = byte(r % 58)
+	r /= 58
+	buf[33] = byte(r % 58)
+	r /= 58
+	buf[32] = byte(r % 58)
+	r /= 58
+	buf[31] = byte(r % 58)
+	r /= 58
+	buf[30] = byte(r)
+
+	// digits 20 to 24
+	r = 0
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	buf[29] = byte(r % 58)
+	r /= 58
+	buf[28] = byte(r % 58)
+	r /= 58
+	buf[27] = byte(r % 58)
+	r /= 58
+	buf[26] = byte(r % 58)
+	r /= 58
+	buf[25] = byte(r)
+
+	// digits 25 to 29
+	r = 0
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	buf[24] = byte(r % 58)
+	r /= 58
+	buf[23] = byte(r % 58)
+	r /= 58
+	buf[22] = byte(r % 58)
+	r /= 58
+	buf[21] = byte(r % 58)
+	r /= 58
+	buf[20] = byte(r)
+
+	// digits 30 to 34
+	r = 0
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	buf[19] = byte(r % 58)
+	r /= 58
+	buf[18] = byte(r % 58)
+	r /= 58
+	buf[17] = byte(r % 58)
+	r /= 58
+	buf[16] = byte(r % 58)
+	r /= 58
+	buf[15] = byte(r)
+
+	// digits 35 to 39
+	r = 0
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	buf[14] = byte(r % 58)
+	r /= 58
+	buf[13] = byte(r % 58)
+	r /= 58
+	buf[12] = byte(r % 58)
+	r /= 58
+	buf[11] = byte(r % 58)
+	r /= 58
+	buf[10] = byte(r)
+
+	// digits 40 to 44
+	r = 0
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	buf[9] = byte(r % 58)
+	r /= 58
+	buf[8] = byte(r % 58)
+	r /= 58
+	buf[7] = byte(r % 58)
+	r /= 58
+	buf[6] = byte(r % 58)
+	r /= 58
+	buf[5] = byte(r)
+
+	// digits 45 to 49
+	r = 0
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	buf[4] = byte(r % 58)
+	r /= 58
+	buf[3] = byte(r % 58)
+	r /= 58
+	buf[2] = byte(r % 58)
+	r /= 58
+	buf[1] = byte(r % 58)
+	r /= 58
+	buf[0] = byte(r)
+
+	i := 0
+	for i < len(buf) && buf[i] == 0 {
+		i++
+	}
+	for z := 0; z < 34 && src[z] == 0; z++ {
+		i--
+	}
+	return i
+}
+
+func encodeUnrolled34BTC(src []byte) string {
+	const chars = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	var buf [50]byte
+	i := encodeDigits34(src, &buf)
+	for j := i; j < len(buf); j++ {
+		buf[j] = chars[buf[j]]
+	}
+	return string(buf[i:])
+}
+
+func encodeUnrolled34Flickr(src []byte) string {
+	const chars = "123456789abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ"
+	var buf [50]byte
+	i := encodeDigits34(src, &buf)
+	for j := i; j < len(buf); j++ {
+		buf[j] = chars[buf[j]]
+	}
+	return string(buf[i:])
+}
+
+// encodeDigits64 writes the base58 digit values of the 64 byte src to the
+// end of buf and returns the start of the encoding, which includes one zero
+// digit per leading zero byte.
+func encodeDigits64(src []byte, buf *[90]byte) int {
+	_ = src[63]
+	x0 := uint32(src[0])<<24 | uint32(src[1])<<16 | uint32(src[2])<<8 | uint32(src[3])
+	x1 := uint32(src[4])<<24 | uint32(src[5])<<16 | uint32(src[6])<<8 | uint32(src[7])
+	x2 := uint32(src[8])<<24 | uint32(src[9])<<16 | uint32(src[10])<<8 | uint32(src[11])
+	x3 := uint32(src[12])<<24 | uint32(src[13])<<16 | uint32(src[14])<<8 | uint32(src[15])
+	x4 := uint32(src[16])<<24 | uint32(src[17])<<16 | uint32(src[18])<<8 | uint32(src[19])
+	x5 := uint32(src[20])<<24 | uint32(src[21])<<16 | uint32(src[22])<<8 | uint32(src[23])
+	x6 := uint32(src[24])<<24 | uint32(src[25])<<16 | uint32(src[26])<<8 | uint32(src[27])
+	x7 := uint32(src[28])<<24 | uint32(src[29])<<16 | uint32(src[30])<<8 | uint32(src[31])
+	x8 := uint32(src[32])<<24 | uint32(src[33])<<16 | uint32(src[34])<<8 | uint32(src[35])
+	x9 := uint32(src[36])<<24 | uint32(src[37])<<16 | uint32(src[38])<<8 | uint32(src[39])
+	x10 := uint32(src[40])<<24 | uint32(src[41])<<16 | uint32(src[42])<<8 | uint32(src[43])
+	x11 := uint32(src[44])<<24 | uint32(src[45])<<16 | uint32(src[46])<<8 | uint32(src[47])
+	x12 := uint32(src[48])<<24 | uint32(src[49])<<16 | uint32(src[50])<<8 | uint32(src[51])
+	x13 := uint32(src[52])<<24 | uint32(src[53])<<16 | uint32(src[54])<<8 | uint32(src[55])
+	x14 := uint32(src[56])<<24 | uint32(src[57])<<16 | uint32(src[58])<<8 | uint32(src[59])
+	x15 := uint32(src[60])<<24 | uint32(src[61])<<16 | uint32(src[62])<<8 | uint32(src[63])
+	var r, t uint64
+
+	// digits 0 to 4
+	r = 0
+	t = r<<32 | uint64(x0)
+	x0 = uint32(t / 656356768)
+	r = t - uint64(x0)*656356768
+	t = r<<32 | uint64(x1)
+	x1 = uint32(t / 656356768)
+	r = t - uint64(x1)*656356768
+	t = r<<32 | uint64(x2)
+	x2 = uint32(t / 656356768)
+	r = t - uint64(x2)*656356768
+	t = r<<32 | uint64(x3)
+	x3 = uint32(t / 656356768)
+	r = t - uint64(x3)*656356768
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	t = r<<32 | uint64(x9)
+	x9 = uint32(t / 656356768)
+	r = t - uint64(x9)*656356768
+	t = r<<32 | uint64(x10)
+	x10 = uint32(t / 656356768)
+	r = t - uint64(x10)*656356768
+	t = r<<32 | uint64(x11)
+	x11 = uint32(t / 656356768)
+	r = t - uint64(x11)*656356768
+	t = r<<32 | uint64(x12)
+	x12 = uint32(t / 656356768)
+	r = t - uint64(x12)*656356768
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	buf[89] = byte(r % 58)
+	r /= 58
+	buf[88] = byte(r % 58)
+	r /= 58
+	buf[87] = byte(r % 58)
+	r /= 58
+	buf[86] = byte(r % 58)
+	r /= 58
+	buf[85] = byte(r)
+
+	// digits 5 to 9
+	r = 0
+	t = r<<32 | uint64(x0)
+	x0 = uint32(t / 656356768)
+	r = t - uint64(x0)*656356768
+	t = r<<32 | uint64(x1)
+	x1 = uint32(t / 656356768)
+	r = t - uint64(x1)*656356768
+	t = r<<32 | uint64(x2)
+	x2 = uint32(t / 656356768)
+	r = t - uint64(x2)*656356768
+	t = r<<32 | uint64(x3)
+	x3 = uint32(t / 656356768)
+	r = t - uint64(x3)*656356768
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	t = r<<32 | uint64(x9)
+	x9 = uint32(t / 656356768)
+	r = t - uint64(x9)*656356768
+	t = r<<32 | uint64(x10)
+	x10 = uint32(t / 656356768)
+	r = t - uint64(x10)*656356768
+	t = r<<32 | uint64(x11)
+	x11 = uint32(t / 656356768)
+	r = t - uint64(x11)*656356768
+	t = r<<32 | uint64(x12)
+	x12 = uint32(t / 656356768)
+	r = t - uint64(x12)*656356768
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	buf[84] = byte(r % 58)
+	r /= 58
+	buf[83] = byte(r % 58)
+	r /= 58
+	buf[82] = byte(r % 58)
+	r /= 58
+	buf[81] = byte(r % 58)
+	r /= 58
+	buf[80] = byte(r)
+
+	// digits 10 to 14
+	r = 0
+	t = r<<32 | uint64(x1)
+	x1 = uint32(t / 656356768)
+	r = t - uint64(x1)*656356768
+	t = r<<32 | uint64(x2)
+	x2 = uint32(t / 656356768)
+	r = t - uint64(x2)*656356768
+	t = r<<32 | uint64(x3)
+	x3 = uint32(t / 656356768)
+	r = t - uint64(x3)*656356768
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	t = r<<32 | uint64(x9)
+	x9 = uint32(t / 656356768)
+	r = t - uint64(x9)*656356768
+	t = r<<32 | uint64(x10)
+	x10 = uint32(t / 656356768)
+	r = t - uint64(x10)*656356768
+	t = r<<32 | uint64(x11)
+	x11 = uint32(t / 656356768)
+	r = t - uint64(x11)*656356768
+	t = r<<32 | uint64(x12)
+	x12 = uint32(t / 656356768)
+	r = t - uint64(x12)*656356768
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	buf[79] = byte(r % 58)
+	r /= 58
+	buf[78] = byte(r % 58)
+	r /= 58
+	buf[77] = byte(r % 58)
+	r /= 58
+	buf[76] = byte(r % 58)
+	r /= 58
+	buf[75] = byte(r)
+
+	// digits 15 to 19
+	r = 0
+	t = r<<32 | uint64(x2)
+	x2 = uint32(t / 656356768)
+	r = t - uint64(x2)*656356768
+	t = r<<32 | uint64(x3)
+	x3 = uint32(t / 656356768)
+	r = t - uint64(x3)*656356768
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	t = r<<32 | uint64(x9)
+	x9 = uint32(t / 656356768)
+	r = t - uint64(x9)*656356768
+	t = r<<32 | uint64(x10)
+	x10 = uint32(t / 656356768)
+	r = t - uint64(x10)*656356768
+	t = r<<32 | uint64(x11)
+	x11 = uint32(t / 656356768)
+	r = t - uint64(x11)*656356768
+	t = r<<32 | uint64(x12)
+	x12 = uint32(t / 656356768)
+	r = t - uint64(x12)*656356768
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	buf[74] = byte(r % 58)
+	r /= 58
+	buf[73] = byte(r % 58)
+	r /= 58
+	buf[72] = byte(r % 58)
+	r /= 58
+	buf[71] = byte(r % 58)
+	r /= 58
+	buf[70] = byte(r)
+
+	// digits 20 to 24
+	r = 0
+	t = r<<32 | uint64(x3)
+	x3 = uint32(t / 656356768)
+	r = t - uint64(x3)*656356768
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	t = r<<32 | uint64(x9)
+	x9 = uint32(t / 656356768)
+	r = t - uint64(x9)*656356768
+	t = r<<32 | uint64(x10)
+	x10 = uint32(t / 656356768)
+	r = t - uint64(x10)*656356768
+	t = r<<32 | uint64(x11)
+	x11 = uint32(t / 656356768)
+	r = t - uint64(x11)*656356768
+	t = r<<32 | uint64(x12)
+	x12 = uint32(t / 656356768)
+	r = t - uint64(x12)*656356768
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	buf[69] = byte(r % 58)
+	r /= 58
+	buf[68] = byte(r % 58)
+	r /= 58
+	buf[67] = byte(r % 58)
+	r /= 58
+	buf[66] = byte(r % 58)
+	r /= 58
+	buf[65] = byte(r)
+
+	// digits 25 to 29
+	r = 0
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	t = r<<32 | uint64(x9)
+	x9 = uint32(t / 656356768)
+	r = t - uint64(x9)*656356768
+	t = r<<32 | uint64(x10)
+	x10 = uint32(t / 656356768)
+	r = t - uint64(x10)*656356768
+	t = r<<32 | uint64(x11)
+	x11 = uint32(t / 656356768)
+	r = t - uint64(x11)*656356768
+	t = r<<32 | uint64(x12)
+	x12 = uint32(t / 656356768)
+	r = t - uint64(x12)*656356768
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	buf[64] = byte(r % 58)
+	r /= 58
+	buf[63] = byte(r % 58)
+	r /= 58
+	buf[62] = byte(r % 58)
+	r /= 58
+	buf[61] = byte(r % 58)
+	r /= 58
+	buf[60] = byte(r)
+
+	// digits 30 to 34
+	r = 0
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	t = r<<32 | uint64(x9)
+	x9 = uint32(t / 656356768)
+	r = t - uint64(x9)*656356768
+	t = r<<32 | uint64(x10)
+	x10 = uint32(t / 656356768)
+	r = t - uint64(x10)*656356768
+	t = r<<32 | uint64(x11)
+	x11 = uint32(t / 656356768)
+	r = t - uint64(x11)*656356768
+	t = r<<32 | uint64(x12)
+	x12 = uint32(t / 656356768)
+	r = t - uint64(x12)*656356768
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	buf[59] = byte(r % 58)
+	r /= 58
+	buf[58] = byte(r % 58)
+	r /= 58
+	buf[57] = byte(r % 58)
+	r /= 58
+	buf[56] = byte(r % 58)
+	r /= 58
+	buf[55] = byte(r)
+
+	// digits 35 to 39
+	r = 0
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	t = r<<32 | uint64(x9)
+	x9 = uint32(t / 656356768)
+	r = t - uint64(x9)*656356768
+	t = r<<32 | uint64(x10)
+	x10 = uint32(t / 656356768)
+	r = t - uint64(x10)*656356768
+	t = r<<32 | uint64(x11)
+	x11 = uint32(t / 656356768)
+	r = t - uint64(x11)*656356768
+	t = r<<32 | uint64(x12)
+	x12 = uint32(t / 656356768)
+	r = t - uint64(x12)*656356768
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	buf[54] = byte(r % 58)
+	r /= 58
+	buf[53] = byte(r % 58)
+	r /= 58
+	buf[52] = byte(r % 58)
+	r /= 58
+	buf[51] = byte(r % 58)
+	r /= 58
+	buf[50] = byte(r)
+
+	// digits 40 to 44
+	r = 0
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	t = r<<32 | uint64(x9)
+	x9 = uint32(t / 656356768)
+	r = t - uint64(x9)*656356768
+	t = r<<32 | uint64(x10)
+	x10 = uint32(t / 656356768)
+	r = t - uint64(x10)*656356768
+	t = r<<32 | uint64(x11)
+	x11 = uint32(t / 656356768)
+	r = t - uint64(x11)*656356768
+	t = r<<32 | uint64(x12)
+	x12 = uint32(t / 656356768)
+	r = t - uint64(x12)*656356768
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	buf[49] = byte(r % 58)
+	r /= 58
+	buf[48] = byte(r % 58)
+	r /= 58
+	buf[47] = byte(r % 58)
+	r /= 58
+	buf[46] = byte(r % 58)
+	r /= 58
+	buf[45] = byte(r)
+
+	// digits 45 to 49
+	r = 0
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	t = r<<32 | uint64(x9)
+	x9 = uint32(t / 656356768)
+	r = t - uint64(x9)*656356768
+	t = r<<32 | uint64(x10)
+	x10 = uint32(t / 656356768)
+	r = t - uint64(x10)*656356768
+	t = r<<32 | uint64(x11)
+	x11 = uint32(t / 656356768)
+	r = t - uint64(x11)*656356768
+	t = r<<32 | uint64(x12)
+	x12 = uint32(t / 656356768)
+	r = t - uint64(x12)*656356768
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	buf[44] = byte(r % 58)
+	r /= 58
+	buf[43] = byte(r % 58)
+	r /= 58
+	buf[42] = byte(r % 58)
+	r /= 58
+	buf[41] = byte(r % 58)
+	r /= 58
+	buf[40] = byte(r)
+
+	// digits 50 to 54
+	r = 0
+	t = r<<32 | uint64(x9)
+	x9 = uint32(t / 656356768)
+	r = t - uint64(x9)*656356768
+	t = r<<32 | uint64(x10)
+	x10 = uint32(t / 656356768)
+	r = t - uint64(x10)*656356768
+	t = r<<32 | uint64(x11)
+	x11 = uint32(t / 656356768)
+	r = t - uint64(x11)*656356768
+	t = r<<32 | uint64(x12)
+	x12 = uint32(t / 656356768)
+	r = t - uint64(x12)*656356768
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	buf[39] = byte(r % 58)
+	r /= 58
+	buf[38] = byte(r % 58)
+	r /= 58
+	buf[37] = byte(r % 58)
+	r /= 58
+	buf[36] = byte(r % 58)
+	r /= 58
+	buf[35] = byte(r)
+
+	// digits 55 to 59
+	r = 0
+	t = r<<32 | uint64(x10)
+	x10 = uint32(t / 656356768)
+	r = t - uint64(x10)*656356768
+	t = r<<32 | uint64(x11)
+	x11 = uint32(t / 656356768)
+	r = t - uint64(x11)*656356768
+	t = r<<32 | uint64(x12)
+	x12 = uint32(t / 656356768)
+	r = t - uint64(x12)*656356768
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	buf[34] = byte(r % 58)
+	r /= 58
+	buf[33] = byte(r % 58)
+	r /= 58
+	buf[32] = byte(r % 58)
+	r /= 58
+	buf[31] = byte(r % 58)
+	r /= 58
+	buf[30] = byte(r)
+
+	// digits 60 to 64
+	r = 0
+	t = r<<32 | uint64(x10)
+	x10 = uint32(t / 656356768)
+	r = t - uint64(x10)*656356768
+	t = r<<32 | uint64(x11)
+	x11 = uint32(t / 656356768)
+	r = t - uint64(x11)*656356768
+	t = r<<32 | uint64(x12)
+	x12 = uint32(t / 656356768)
+	r = t - uint64(x12)*656356768
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	buf[29] = byte(r % 58)
+	r /= 58
+	buf[28] = byte(r % 58)
+	r /= 58
+	buf[27] = byte(r % 58)
+	r /= 58
+	buf[26] = byte(r % 58)
+	r /= 58
+	buf[25] = byte(r)
+
+	// digits 65 to 69
+	r = 0
+	t = r<<32 | uint64(x11)
+	x11 = uint32(t / 656356768)
+	r = t - uint64(x11)*656356768
+	t = r<<32 | uint64(x12)
+	x12 = uint32(t / 656356768)
+	r = t - uint64(x12)*656356768
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	buf[24] = byte(r % 58)
+	r /= 58
+	buf[23] = byte(r % 58)
+	r /= 58
+	buf[22] = byte(r % 58)
+	r /= 58
+	buf[21] = byte(r % 58)
+	r /= 58
+	buf[20] = byte(r)
+
+	// digits 70 to 74
+	r = 0
+	t = r<<32 | uint64(x12)
+	x12 = uint32(t / 656356768)
+	r = t - uint64(x12)*656356768
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	buf[19] = byte(r % 58)
+	r /= 58
+	buf[18] = byte(r % 58)
+	r /= 58
+	buf[17] = byte(r % 58)
+	r /= 58
+	buf[16] = byte(r % 58)
+	r /= 58
+	buf[15] = byte(r)
+
+	// digits 75 to 79
+	r = 0
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	buf[14] = byte(r % 58)
+	r /= 58
+	buf[13] = byte(r % 58)
+	r /= 58
+	buf[12] = byte(r % 58)
+	r /= 58
+	buf[11] = byte(r % 58)
+	r /= 58
+	buf[10] = byte(r)
+
+	// digits 80 to 84
+	r = 0
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	buf[9] = byte(r % 58)
+	r /= 58
+	buf[8] = byte(r % 58)
+	r /= 58
+	buf[7] = byte(r % 58)
+	r /= 58
+	buf[6] = byte(r % 58)
+	r /= 58
+	buf[5] = byte(r)
+
+	// digits 85 to 89
+	r = 0
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	buf[4] = byte(r % 58)
+	r /= 58
+	buf[3] = byte(r % 58)
+	r /= 58
+	buf[2] = byte(r % 58)
+	r /= 58
+	buf[1] = byte(r % 58)
+	r /= 58
+	buf[0] = byte(r)
+
+	i := 0
+	for i < len(buf) && buf[i] == 0 {
+		i++
+	}
+	for z := 0; z < 64 && src[z] == 0; z++ {
+		i--
+	}
+	return i
+}
+
+func encodeUnrolled64BTC(src []byte) string {
+	const chars = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	var buf [90]byte
+	i := encodeDigits64(src, &buf)
+	for j := i; j < len(buf); j++ {
+		buf[j] = chars[buf[j]]
+	}
+	return string(buf[i:])
+}
+
+func encodeUnrolled64Flickr(src []byte) string {
+	const chars = "123456789abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ"
+	var buf [90]byte
+	i := encodeDigits64(src, &buf)
+	for j := i; j < len(buf); j++ {
+		buf[j] = chars[buf[j]]
+	}
+	return string(buf[i:])
+}
+
+// encodeDigits82 writes the base58 digit values of the 82 byte src to the
+// end of buf and returns the start of the encoding, which includes one zero
+// digit per leading zero byte.
+func encodeDigits82(src []byte, buf *[115]byte) int {
+	_ = src[81]
+	x0 := uint32(src[0])<<8 | uint32(src[1])
+	x1 := uint32(src[2])<<24 | uint32(src[3])<<16 | uint32(src[4])<<8 | uint32(src[5])
+	x2 := uint32(src[6])<<24 | uint32(src[7])<<16 | uint32(src[8])<<8 | uint32(src[9])
+	x3 := uint32(src[10])<<24 | uint32(src[11])<<16 | uint32(src[12])<<8 | uint32(src[13])
+	x4 := uint32(src[14])<<24 | uint32(src[15])<<16 | uint32(src[16])<<8 | uint32(src[17])
+	x5 := uint32(src[18])<<24 | uint32(src[19])<<16 | uint32(src[20])<<8 | uint32(src[21])
+	x6 := uint32(src[22])<<24 | uint32(src[23])<<16 | uint32(src[24])<<8 | uint32(src[25])
+	x7 := uint32(src[26])<<24 | uint32(src[27])<<16 | uint32(src[28])<<8 | uint32(src[29])
+	x8 := uint32(src[30])<<24 | uint32(src[31])<<16 | uint32(src[32])<<8 | uint32(src[33])
+	x9 := uint32(src[34])<<24 | uint32(src[35])<<16 | uint32(src[36])<<8 | uint32(src[37])
+	x10 := uint32(src[38])<<24 | uint32(src[39])<<16 | uint32(src[40])<<8 | uint32(src[41])
+	x11 := uint32(src[42])<<24 | uint32(src[43])<<16 | uint32(src[44])<<8 | uint32(src[45])
+	x12 := uint32(src[46])<<24 | uint32(src[47])<<16 | uint32(src[48])<<8 | uint32(src[49])
+	x13 := uint32(src[50])<<24 | uint32(src[51])<<16 | uint32(src[52])<<8 | uint32(src[53])
+	x14 := uint32(src[54])<<24 | uint32(src[55])<<16 | uint32(src[56])<<8 | uint32(src[57])
+	x15 := uint32(src[58])<<24 | uint32(src[59])<<16 | uint32(src[60])<<8 | uint32(src[61])
+	x16 := uint32(src[62])<<24 | uint32(src[63])<<16 | uint32(src[64])<<8 | uint32(src[65])
+	x17 := uint32(src[66])<<24 | uint32(src[67])<<16 | uint32(src[68])<<8 | uint32(src[69])
+	x18 := uint32(src[70])<<24 | uint32(src[71])<<16 | uint32(src[72])<<8 | uint32(src[73])
+	x19 := uint32(src[74])<<24 | uint32(src[75])<<16 | uint32(src[76])<<8 | uint32(src[77])
+	x20 := uint32(src[78])<<24 | uint32(src[79])<<16 | uint32(src[80])<<8 | uint32(src[81])
+	var r, t uint64
+
+	// digits 0 to 4
+	r = 0
+	t = r<<32 | uint64(x0)
+	x0 = uint32(t / 656356768)
+	r = t - uint64(x0)*656356768
+	t = r<<32 | uint64(x1)
+	x1 = uint32(t / 656356768)
+	r = t - uint64(x1)*656356768
+	t = r<<32 | uint64(x2)
+	x2 = uint32(t / 656356768)
+	r = t - uint64(x2)*656356768
+	t = r<<32 | uint64(x3)
+	x3 = uint32(t / 656356768)
+	r = t - uint64(x3)*656356768
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	t = r<<32 | uint64(x9)
+	x9 = uint32(t / 656356768)
+	r = t - uint64(x9)*656356768
+	t = r<<32 | uint64(x10)
+	x10 = uint32(t / 656356768)
+	r = t - uint64(x10)*656356768
+	t = r<<32 | uint64(x11)
+	x11 = uint32(t / 656356768)
+	r = t - uint64(x11)*656356768
+	t = r<<32 | uint64(x12)
+	x12 = uint32(t / 656356768)
+	r = t - uint64(x12)*656356768
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	t = r<<32 | uint64(x16)
+	x16 = uint32(t / 656356768)
+	r = t - uint64(x16)*656356768
+	t = r<<32 | uint64(x17)
+	x17 = uint32(t / 656356768)
+	r = t - uint64(x17)*656356768
+	t = r<<32 | uint64(x18)
+	x18 = uint32(t / 656356768)
+	r = t - uint64(x18)*656356768
+	t = r<<32 | uint64(x19)
+	x19 = uint32(t / 656356768)
+	r = t - uint64(x19)*656356768
+	t = r<<32 | uint64(x20)
+	x20 = uint32(t / 656356768)
+	r = t - uint64(x20)*656356768
+	buf[114] = byte(r % 58)
+	r /= 58
+	buf[113] = byte(r % 58)
+	r /= 58
+	buf[112] = byte(r % 58)
+	r /= 58
+	buf[111] = byte(r % 58)
+	r /= 58
+	buf[110] = byte(r)
+
+	// digits 5 to 9
+	r = 0
+	t = r<<32 | uint64(x1)
+	x1 = uint32(t / 656356768)
+	r = t - uint64(x1)*656356768
+	t = r<<32 | uint64(x2)
+	x2 = uint32(t / 656356768)
+	r = t - uint64(x2)*656356768
+	t = r<<32 | uint64(x3)
+	x3 = uint32(t / 656356768)
+	r = t - uint64(x3)*656356768
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	t = r<<32 | uint64(x9)
+	x9 = uint32(t / 656356768)
+	r = t - uint64(x9)*656356768
+	t = r<<32 | uint64(x10)
+	x10 = uint32(t / 656356768)
+	r = t - uint64(x10)*656356768
+	t = r<<32 | uint64(x11)
+	x11 = uint32(t / 656356768)
+	r = t - uint64(x11)*656356768
+	t = r<<32 | uint64(x12)
+	x12 = uint32(t / 656356768)
+	r = t - uint64(x12)*656356768
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	t = r<<32 | uint64(x16)
+	x16 = uint32(t / 656356768)
+	r = t - uint64(x16)*656356768
+	t = r<<32 | uint64(x17)
+	x17 = uint32(t / 656356768)
+	r = t - uint64(x17)*656356768
+	t = r<<32 | uint64(x18)
+	x18 = uint32(t / 656356768)
+	r = t - uint64(x18)*656356768
+	t = r<<32 | uint64(x19)
+	x19 = uint32(t / 656356768)
+	r = t - uint64(x19)*656356768
+	t = r<<32 | uint64(x20)
+	x20 = uint32(t / 656356768)
+	r = t - uint64(x20)*656356768
+	buf[109] = byte(r % 58)
+	r /= 58
+	buf[108] = byte(r % 58)
+	r /= 58
+	buf[107] = byte(r % 58)
+	r /= 58
+	buf[106] = byte(r % 58)
+	r /= 58
+	buf[105] = byte(r)
+
+	// digits 10 to 14
+	r = 0
+	t = r<<32 | uint64(x2)
+	x2 = uint32(t / 656356768)
+	r = t - uint64(x2)*656356768
+	t = r<<32 | uint64(x3)
+	x3 = uint32(t / 656356768)
+	r = t - uint64(x3)*656356768
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	t = r<<32 | uint64(x9)
+	x9 = uint32(t / 656356768)
+	r = t - uint64(x9)*656356768
+	t = r<<32 | uint64(x10)
+	x10 = uint32(t / 656356768)
+	r = t - uint64(x10)*656356768
+	t = r<<32 | uint64(x11)
+	x11 = uint32(t / 656356768)
+	r = t - uint64(x11)*656356768
+	t = r<<32 | uint64(x12)
+	x12 = uint32(t / 656356768)
+	r = t - uint64(x12)*656356768
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	t = r<<32 | uint64(x16)
+	x16 = uint32(t / 656356768)
+	r = t - uint64(x16)*656356768
+	t = r<<32 | uint64(x17)
+	x17 = uint32(t / 656356768)
+	r = t - uint64(x17)*656356768
+	t = r<<32 | uint64(x18)
+	x18 = uint32(t / 656356768)
+	r = t - uint64(x18)*656356768
+	t = r<<32 | uint64(x19)
+	x19 = uint32(t / 656356768)
+	r = t - uint64(x19)*656356768
+	t = r<<32 | uint64(x20)
+	x20 = uint32(t / 656356768)
+	r = t - uint64(x20)*656356768
+	buf[104] = byte(r % 58)
+	r /= 58
+	buf[103] = byte(r % 58)
+	r /= 58
+	buf[102] = byte(r % 58)
+	r /= 58
+	buf[101] = byte(r % 58)
+	r /= 58
+	buf[100] = byte(r)
+
+	// digits 15 to 19
+	r = 0
+	t = r<<32 | uint64(x3)
+	x3 = uint32(t / 656356768)
+	r = t - uint64(x3)*656356768
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	t = r<<32 | uint64(x9)
+	x9 = uint32(t / 656356768)
+	r = t - uint64(x9)*656356768
+	t = r<<32 | uint64(x10)
+	x10 = uint32(t / 656356768)
+	r = t - uint64(x10)*656356768
+	t = r<<32 | uint64(x11)
+	x11 = uint32(t / 656356768)
+	r = t - uint64(x11)*656356768
+	t = r<<32 | uint64(x12)
+	x12 = uint32(t / 656356768)
+	r = t - uint64(x12)*656356768
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	t = r<<32 | uint64(x16)
+	x16 = uint32(t / 656356768)
+	r = t - uint64(x16)*656356768
+	t = r<<32 | uint64(x17)
+	x17 = uint32(t / 656356768)
+	r = t - uint64(x17)*656356768
+	t = r<<32 | uint64(x18)
+	x18 = uint32(t / 656356768)
+	r = t - uint64(x18)*656356768
+	t = r<<32 | uint64(x19)
+	x19 = uint32(t / 656356768)
+	r = t - uint64(x19)*656356768
+	t = r<<32 | uint64(x20)
+	x20 = uint32(t / 656356768)
+	r = t - uint64(x20)*656356768
+	buf[99] = byte(r % 58)
+	r /= 58
+	buf[98] = byte(r % 58)
+	r /= 58
+	buf[97] = byte(r % 58)
+	r /= 58
+	buf[96] = byte(r % 58)
+	r /= 58
+	buf[95] = byte(r)
+
+	// digits 20 to 24
+	r = 0
+	t = r<<32 | uint64(x4)
+	x4 = uint32(t / 656356768)
+	r = t - uint64(x4)*656356768
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	t = r<<32 | uint64(x9)
+	x9 = uint32(t / 656356768)
+	r = t - uint64(x9)*656356768
+	t = r<<32 | uint64(x10)
+	x10 = uint32(t / 656356768)
+	r = t - uint64(x10)*656356768
+	t = r<<32 | uint64(x11)
+	x11 = uint32(t / 656356768)
+	r = t - uint64(x11)*656356768
+	t = r<<32 | uint64(x12)
+	x12 = uint32(t / 656356768)
+	r = t - uint64(x12)*656356768
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	t = r<<32 | uint64(x16)
+	x16 = uint32(t / 656356768)
+	r = t - uint64(x16)*656356768
+	t = r<<32 | uint64(x17)
+	x17 = uint32(t / 656356768)
+	r = t - uint64(x17)*656356768
+	t = r<<32 | uint64(x18)
+	x18 = uint32(t / 656356768)
+	r = t - uint64(x18)*656356768
+	t = r<<32 | uint64(x19)
+	x19 = uint32(t / 656356768)
+	r = t - uint64(x19)*656356768
+	t = r<<32 | uint64(x20)
+	x20 = uint32(t / 656356768)
+	r = t - uint64(x20)*656356768
+	buf[94] = byte(r % 58)
+	r /= 58
+	buf[93] = byte(r % 58)
+	r /= 58
+	buf[92] = byte(r % 58)
+	r /= 58
+	buf[91] = byte(r % 58)
+	r /= 58
+	buf[90] = byte(r)
+
+	// digits 25 to 29
+	r = 0
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	t = r<<32 | uint64(x9)
+	x9 = uint32(t / 656356768)
+	r = t - uint64(x9)*656356768
+	t = r<<32 | uint64(x10)
+	x10 = uint32(t / 656356768)
+	r = t - uint64(x10)*656356768
+	t = r<<32 | uint64(x11)
+	x11 = uint32(t / 656356768)
+	r = t - uint64(x11)*656356768
+	t = r<<32 | uint64(x12)
+	x12 = uint32(t / 656356768)
+	r = t - uint64(x12)*656356768
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	t = r<<32 | uint64(x16)
+	x16 = uint32(t / 656356768)
+	r = t - uint64(x16)*656356768
+	t = r<<32 | uint64(x17)
+	x17 = uint32(t / 656356768)
+	r = t - uint64(x17)*656356768
+	t = r<<32 | uint64(x18)
+	x18 = uint32(t / 656356768)
+	r = t - uint64(x18)*656356768
+	t = r<<32 | uint64(x19)
+	x19 = uint32(t / 656356768)
+	r = t - uint64(x19)*656356768
+	t = r<<32 | uint64(x20)
+	x20 = uint32(t / 656356768)
+	r = t - uint64(x20)*656356768
+	buf[89] = byte(r % 58)
+	r /= 58
+	buf[88] = byte(r % 58)
+	r /= 58
+	buf[87] = byte(r % 58)
+	r /= 58
+	buf[86] = byte(r % 58)
+	r /= 58
+	buf[85] = byte(r)
+
+	// digits 30 to 34
+	r = 0
+	t = r<<32 | uint64(x5)
+	x5 = uint32(t / 656356768)
+	r = t - uint64(x5)*656356768
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	t = r<<32 | uint64(x9)
+	x9 = uint32(t / 656356768)
+	r = t - uint64(x9)*656356768
+	t = r<<32 | uint64(x10)
+	x10 = uint32(t / 656356768)
+	r = t - uint64(x10)*656356768
+	t = r<<32 | uint64(x11)
+	x11 = uint32(t / 656356768)
+	r = t - uint64(x11)*656356768
+	t = r<<32 | uint64(x12)
+	x12 = uint32(t / 656356768)
+	r = t - uint64(x12)*656356768
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	t = r<<32 | uint64(x16)
+	x16 = uint32(t / 656356768)
+	r = t - uint64(x16)*656356768
+	t = r<<32 | uint64(x17)
+	x17 = uint32(t / 656356768)
+	r = t - uint64(x17)*656356768
+	t = r<<32 | uint64(x18)
+	x18 = uint32(t / 656356768)
+	r = t - uint64(x18)*656356768
+	t = r<<32 | uint64(x19)
+	x19 = uint32(t / 656356768)
+	r = t - uint64(x19)*656356768
+	t = r<<32 | uint64(x20)
+	x20 = uint32(t / 656356768)
+	r = t - uint64(x20)*656356768
+	buf[84] = byte(r % 58)
+	r /= 58
+	buf[83] = byte(r % 58)
+	r /= 58
+	buf[82] = byte(r % 58)
+	r /= 58
+	buf[81] = byte(r % 58)
+	r /= 58
+	buf[80] = byte(r)
+
+	// digits 35 to 39
+	r = 0
+	t = r<<32 | uint64(x6)
+	x6 = uint32(t / 656356768)
+	r = t - uint64(x6)*656356768
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	t = r<<32 | uint64(x9)
+	x9 = uint32(t / 656356768)
+	r = t - uint64(x9)*656356768
+	t = r<<32 | uint64(x10)
+	x10 = uint32(t / 656356768)
+	r = t - uint64(x10)*656356768
+	t = r<<32 | uint64(x11)
+	x11 = uint32(t / 656356768)
+	r = t - uint64(x11)*656356768
+	t = r<<32 | uint64(x12)
+	x12 = uint32(t / 656356768)
+	r = t - uint64(x12)*656356768
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	t = r<<32 | uint64(x16)
+	x16 = uint32(t / 656356768)
+	r = t - uint64(x16)*656356768
+	t = r<<32 | uint64(x17)
+	x17 = uint32(t / 656356768)
+	r = t - uint64(x17)*656356768
+	t = r<<32 | uint64(x18)
+	x18 = uint32(t / 656356768)
+	r = t - uint64(x18)*656356768
+	t = r<<32 | uint64(x19)
+	x19 = uint32(t / 656356768)
+	r = t - uint64(x19)*656356768
+	t = r<<32 | uint64(x20)
+	x20 = uint32(t / 656356768)
+	r = t - uint64(x20)*656356768
+	buf[79] = byte(r % 58)
+	r /= 58
+	buf[78] = byte(r % 58)
+	r /= 58
+	buf[77] = byte(r % 58)
+	r /= 58
+	buf[76] = byte(r % 58)
+	r /= 58
+	buf[75] = byte(r)
+
+	// digits 40 to 44
+	r = 0
+	t = r<<32 | uint64(x7)
+	x7 = uint32(t / 656356768)
+	r = t - uint64(x7)*656356768
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	t = r<<32 | uint64(x9)
+	x9 = uint32(t / 656356768)
+	r = t - uint64(x9)*656356768
+	t = r<<32 | uint64(x10)
+	x10 = uint32(t / 656356768)
+	r = t - uint64(x10)*656356768
+	t = r<<32 | uint64(x11)
+	x11 = uint32(t / 656356768)
+	r = t - uint64(x11)*656356768
+	t = r<<32 | uint64(x12)
+	x12 = uint32(t / 656356768)
+	r = t - uint64(x12)*656356768
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	t = r<<32 | uint64(x16)
+	x16 = uint32(t / 656356768)
+	r = t - uint64(x16)*656356768
+	t = r<<32 | uint64(x17)
+	x17 = uint32(t / 656356768)
+	r = t - uint64(x17)*656356768
+	t = r<<32 | uint64(x18)
+	x18 = uint32(t / 656356768)
+	r = t - uint64(x18)*656356768
+	t = r<<32 | uint64(x19)
+	x19 = uint32(t / 656356768)
+	r = t - uint64(x19)*656356768
+	t = r<<32 | uint64(x20)
+	x20 = uint32(t / 656356768)
+	r = t - uint64(x20)*656356768
+	buf[74] = byte(r % 58)
+	r /= 58
+	buf[73] = byte(r % 58)
+	r /= 58
+	buf[72] = byte(r % 58)
+	r /= 58
+	buf[71] = byte(r % 58)
+	r /= 58
+	buf[70] = byte(r)
+
+	// digits 45 to 49
+	r = 0
+	t = r<<32 | uint64(x8)
+	x8 = uint32(t / 656356768)
+	r = t - uint64(x8)*656356768
+	t = r<<32 | uint64(x9)
+	x9 = uint32(t / 656356768)
+	r = t - uint64(x9)*656356768
+	t = r<<32 | uint64(x10)
+	x10 = uint32(t / 656356768)
+	r = t - uint64(x10)*656356768
+	t = r<<32 | uint64(x11)
+	x11 = uint32(t / 656356768)
+	r = t - uint64(x11)*656356768
+	t = r<<32 | uint64(x12)
+	x12 = uint32(t / 656356768)
+	r = t - uint64(x12)*656356768
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	t = r<<32 | uint64(x16)
+	x16 = uint32(t / 656356768)
+	r = t - uint64(x16)*656356768
+	t = r<<32 | uint64(x17)
+	x17 = uint32(t / 656356768)
+	r = t - uint64(x17)*656356768
+	t = r<<32 | uint64(x18)
+	x18 = uint32(t / 656356768)
+	r = t - uint64(x18)*656356768
+	t = r<<32 | uint64(x19)
+	x19 = uint32(t / 656356768)
+	r = t - uint64(x19)*656356768
+	t = r<<32 | uint64(x20)
+	x20 = uint32(t / 656356768)
+	r = t - uint64(x20)*656356768
+	buf[69] = byte(r % 58)
+	r /= 58
+	buf[68] = byte(r % 58)
+	r /= 58
+	buf[67] = byte(r % 58)
+	r /= 58
+	buf[66] = byte(r % 58)
+	r /= 58
+	buf[65] = byte(r)
+
+	// digits 50 to 54
+	r = 0
+	t = r<<32 | uint64(x9)
+	x9 = uint32(t / 656356768)
+	r = t - uint64(x9)*656356768
+	t = r<<32 | uint64(x10)
+	x10 = uint32(t / 656356768)
+	r = t - uint64(x10)*656356768
+	t = r<<32 | uint64(x11)
+	x11 = uint32(t / 656356768)
+	r = t - uint64(x11)*656356768
+	t = r<<32 | uint64(x12)
+	x12 = uint32(t / 656356768)
+	r = t - uint64(x12)*656356768
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	t = r<<32 | uint64(x16)
+	x16 = uint32(t / 656356768)
+	r = t - uint64(x16)*656356768
+	t = r<<32 | uint64(x17)
+	x17 = uint32(t / 656356768)
+	r = t - uint64(x17)*656356768
+	t = r<<32 | uint64(x18)
+	x18 = uint32(t / 656356768)
+	r = t - uint64(x18)*656356768
+	t = r<<32 | uint64(x19)
+	x19 = uint32(t / 656356768)
+	r = t - uint64(x19)*656356768
+	t = r<<32 | uint64(x20)
+	x20 = uint32(t / 656356768)
+	r = t - uint64(x20)*656356768
+	buf[64] = byte(r % 58)
+	r /= 58
+	buf[63] = byte(r % 58)
+	r /= 58
+	buf[62] = byte(r % 58)
+	r /= 58
+	buf[61] = byte(r % 58)
+	r /= 58
+	buf[60] = byte(r)
+
+	// digits 55 to 59
+	r = 0
+	t = r<<32 | uint64(x10)
+	x10 = uint32(t / 656356768)
+	r = t - uint64(x10)*656356768
+	t = r<<32 | uint64(x11)
+	x11 = uint32(t / 656356768)
+	r = t - uint64(x11)*656356768
+	t = r<<32 | uint64(x12)
+	x12 = uint32(t / 656356768)
+	r = t - uint64(x12)*656356768
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	t = r<<32 | uint64(x16)
+	x16 = uint32(t / 656356768)
+	r = t - uint64(x16)*656356768
+	t = r<<32 | uint64(x17)
+	x17 = uint32(t / 656356768)
+	r = t - uint64(x17)*656356768
+	t = r<<32 | uint64(x18)
+	x18 = uint32(t / 656356768)
+	r = t - uint64(x18)*656356768
+	t = r<<32 | uint64(x19)
+	x19 = uint32(t / 656356768)
+	r = t - uint64(x19)*656356768
+	t = r<<32 | uint64(x20)
+	x20 = uint32(t / 656356768)
+	r = t - uint64(x20)*656356768
+	buf[59] = byte(r % 58)
+	r /= 58
+	buf[58] = byte(r % 58)
+	r /= 58
+	buf[57] = byte(r % 58)
+	r /= 58
+	buf[56] = byte(r % 58)
+	r /= 58
+	buf[55] = byte(r)
+
+	// digits 60 to 64
+	r = 0
+	t = r<<32 | uint64(x11)
+	x11 = uint32(t / 656356768)
+	r = t - uint64(x11)*656356768
+	t = r<<32 | uint64(x12)
+	x12 = uint32(t / 656356768)
+	r = t - uint64(x12)*656356768
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	t = r<<32 | uint64(x16)
+	x16 = uint32(t / 656356768)
+	r = t - uint64(x16)*656356768
+	t = r<<32 | uint64(x17)
+	x17 = uint32(t / 656356768)
+	r = t - uint64(x17)*656356768
+	t = r<<32 | uint64(x18)
+	x18 = uint32(t / 656356768)
+	r = t - uint64(x18)*656356768
+	t = r<<32 | uint64(x19)
+	x19 = uint32(t / 656356768)
+	r = t - uint64(x19)*656356768
+	t = r<<32 | uint64(x20)
+	x20 = uint32(t / 656356768)
+	r = t - uint64(x20)*656356768
+	buf[54] = byte(r % 58)
+	r /= 58
+	buf[53] = byte(r % 58)
+	r /= 58
+	buf[52] = byte(r % 58)
+	r /= 58
+	buf[51] = byte(r % 58)
+	r /= 58
+	buf[50] = byte(r)
+
+	// digits 65 to 69
+	r = 0
+	t = r<<32 | uint64(x12)
+	x12 = uint32(t / 656356768)
+	r = t - uint64(x12)*656356768
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	t = r<<32 | uint64(x16)
+	x16 = uint32(t / 656356768)
+	r = t - uint64(x16)*656356768
+	t = r<<32 | uint64(x17)
+	x17 = uint32(t / 656356768)
+	r = t - uint64(x17)*656356768
+	t = r<<32 | uint64(x18)
+	x18 = uint32(t / 656356768)
+	r = t - uint64(x18)*656356768
+	t = r<<32 | uint64(x19)
+	x19 = uint32(t / 656356768)
+	r = t - uint64(x19)*656356768
+	t = r<<32 | uint64(x20)
+	x20 = uint32(t / 656356768)
+	r = t - uint64(x20)*656356768
+	buf[49] = byte(r % 58)
+	r /= 58
+	buf[48] = byte(r % 58)
+	r /= 58
+	buf[47] = byte(r % 58)
+	r /= 58
+	buf[46] = byte(r % 58)
+	r /= 58
+	buf[45] = byte(r)
+
+	// digits 70 to 74
+	r = 0
+	t = r<<32 | uint64(x13)
+	x13 = uint32(t / 656356768)
+	r = t - uint64(x13)*656356768
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	t = r<<32 | uint64(x16)
+	x16 = uint32(t / 656356768)
+	r = t - uint64(x16)*656356768
+	t = r<<32 | uint64(x17)
+	x17 = uint32(t / 656356768)
+	r = t - uint64(x17)*656356768
+	t = r<<32 | uint64(x18)
+	x18 = uint32(t / 656356768)
+	r = t - uint64(x18)*656356768
+	t = r<<32 | uint64(x19)
+	x19 = uint32(t / 656356768)
+	r = t - uint64(x19)*656356768
+	t = r<<32 | uint64(x20)
+	x20 = uint32(t / 656356768)
+	r = t - uint64(x20)*656356768
+	buf[44] = byte(r % 58)
+	r /= 58
+	buf[43] = byte(r % 58)
+	r /= 58
+	buf[42] = byte(r % 58)
+	r /= 58
+	buf[41] = byte(r % 58)
+	r /= 58
+	buf[40] = byte(r)
+
+	// digits 75 to 79
+	r = 0
+	t = r<<32 | uint64(x14)
+	x14 = uint32(t / 656356768)
+	r = t - uint64(x14)*656356768
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	t = r<<32 | uint64(x16)
+	x16 = uint32(t / 656356768)
+	r = t - uint64(x16)*656356768
+	t = r<<32 | uint64(x17)
+	x17 = uint32(t / 656356768)
+	r = t - uint64(x17)*656356768
+	t = r<<32 | uint64(x18)
+	x18 = uint32(t / 656356768)
+	r = t - uint64(x18)*656356768
+	t = r<<32 | uint64(x19)
+	x19 = uint32(t / 656356768)
+	r = t - uint64(x19)*656356768
+	t = r<<32 | uint64(x20)
+	x20 = uint32(t / 656356768)
+	r = t - uint64(x20)*656356768
+	buf[39] = byte(r % 58)
+	r /= 58
+	buf[38] = byte(r % 58)
+	r /= 58
+	buf[37] = byte(r % 58)
+	r /= 58
+	buf[36] = byte(r % 58)
+	r /= 58
+	buf[35] = byte(r)
+
+	// digits 80 to 84
+	r = 0
+	t = r<<32 | uint64(x15)
+	x15 = uint32(t / 656356768)
+	r = t - uint64(x15)*656356768
+	t = r<<32 | uint64(x16)
+	x16 = uint32(t / 656356768)
+	r = t - uint64(x16)*656356768
+	t = r<<32 | uint64(x17)
+	x17 = uint32(t / 656356768)
+	r = t - uint64(x17)*656356768
+	t = r<<32 | uint64(x18)
+	x18 = uint32(t / 656356768)
+	r = t - uint64(x18)*656356768
+	t = r<<32 | uint64(x19)
+	x19 = uint32(t / 656356768)
+	r = t - uint64(x19)*656356768
+	t = r<<32 | uint64(x20)
+	x20 = uint32(t / 656356768)
+	r = t - uint64(x20)*656356768
+	buf[34] = byte(r % 58)
+	r /= 58
+	buf[33] = byte(r % 58)
+	r /= 58
+	buf[32] = byte(r % 58)
+	r /= 58
+	buf[31] = byte(r % 58)
+	r /= 58
+	buf[30] = byte(r)
+
+	// digits 85 to 89
+	r = 0
+	t = r<<32 | uint64(x16)
+	x16 = uint32(t / 656356768)
+	r = t - uint64(x16)*656356768
+	t = r<<32 | uint64(x17)
+	x17 = uint32(t / 656356768)
+	r = t - uint64(x17)*656356768
+	t = r<<32 | uint64(x18)
+	x18 = uint32(t / 656356768)
+	r = t - uint64(x18)*656356768
+	t = r<<32 | uint64(x19)
+	x19 = uint32(t / 656356768)
+	r = t - uint64(x19)*656356768
+	t = r<<32 | uint64(x20)
+	x20 = uint32(t / 656356768)
+	r = t - uint64(x20)*656356768
+	buf[29] = byte(r % 58)
+	r /= 58
+	buf[28] = byte(r % 58)
+	r /= 58
+	buf[27] = byte(r % 58)
+	r /= 58
+	buf[26] = byte(r % 58)
+	r /= 58
+	buf[25] = byte(r)
+
+	// digits 90 to 94
+	r = 0
+	t = r<<32 | uint64(x16)
+	x16 = uint32(t / 656356768)
+	r = t - uint64(x16)*656356768
+	t = r<<32 | uint64(x17)
+	x17 = uint32(t / 656356768)
+	r = t - uint64(x17)*656356768
+	t = r<<32 | uint64(x18)
+	x18 = uint32(t / 656356768)
+	r = t - uint64(x18)*656356768
+	t = r<<32 | uint64(x19)
+	x19 = uint32(t / 656356768)
+	r = t - uint64(x19)*656356768
+	t = r<<32 | uint64(x20)
+	x20 = uint32(t / 656356768)
+	r = t - uint64(x20)*656356768
+	buf[24] = byte(r % 58)
+	r /= 58
+	buf[23] = byte(r % 58)
+	r /= 58
+	buf[22] = byte(r % 58)
+	r /= 58
+	buf[21] = byte(r % 58)
+	r /= 58
+	buf[20] = byte(r)
+
+	// digits 95 to 99
+	r = 0
+	t = r<<32 | uint64(x17)
+	x17 = uint32(t / 656356768)
+	r = t - uint64(x17)*656356768
+	t = r<<32 | uint64(x18)
+	x18 = uint32(t / 656356768)
+	r = t - uint64(x18)*656356768
+	t = r<<32 | uint64(x19)
+	x19 = uint32(t / 656356768)
+	r = t - uint64(x19)*656356768
+	t = r<<32 | uint64(x20)
+	x20 = uint32(t / 656356768)
+	r = t - uint64(x20)*656356768
+	buf[19] = byte(r % 58)
+	r /= 58
+	buf[18] = byte(r % 58)
+	r /= 58
+	buf[17] = byte(r % 58)
+	r /= 58
+	buf[16] = byte(r % 58)
+	r /= 58
+	buf[15] = byte(r)
+
+	// digits 100 to 104
+	r = 0
+	t = r<<32 | uint64(x18)
+	x18 = uint32(t / 656356768)
+	r = t - uint64(x18)*656356768
+	t = r<<32 | uint64(x19)
+	x19 = uint32(t / 656356768)
+	r = t - uint64(x19)*656356768
+	t = r<<32 | uint64(x20)
+	x20 = uint32(t / 656356768)
+	r = t - uint64(x20)*656356768
+	buf[14] = byte(r % 58)
+	r /= 58
+	buf[13] = byte(r % 58)
+	r /= 58
+	buf[12] = byte(r % 58)
+	r /= 58
+	buf[11] = byte(r % 58)
+	r /= 58
+	buf[10] = byte(r)
+
+	// digits 105 to 109
+	r = 0
+	t = r<<32 | uint64(x19)
+	x19 = uint32(t / 656356768)
+	r = t - uint64(x19)*656356768
+	t = r<<32 | uint64(x20)
+	x20 = uint32(t / 656356768)
+	r = t - uint64(x20)*656356768
+	buf[9] = byte(r % 58)
+	r /= 58
+	buf[8] = byte(r % 58)
+	r /= 58
+	buf[7] = byte(r % 58)
+	r /= 58
+	buf[6] = byte(r % 58)
+	r /= 58
+	buf[5] = byte(r)
+
+	// digits 110 to 114
+	r = 0
+	t = r<<32 | uint64(x20)
+	x20 = uint32(t / 656356768)
+	r = t - uint64(x20)*656356768
+	buf[4] = byte(r % 58)
+	r /= 58
+	buf[3] = byte(r % 58)
+	r /= 58
+	buf[2] = byte(r % 58)
+	r /= 58
+	buf[1] = byte(r % 58)
+	r /= 58
+	buf[0] = byte(r)
+
+	i := 0
+	for i < len(buf) && buf[i] == 0 {
+		i++
+	}
+	for z := 0; z < 82 && src[z] == 0; z++ {
+		i--
+	}
+	return i
+}
+
+func encodeUnrolled82BTC(src []byte) string {
+	const chars = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	var buf [115]byte
+	i := encodeDigits82(src, &buf)
+	for j := i; j < len(buf); j++ {
+		buf[j] = chars[buf[j]]
+	}
+	return string(buf[i:])
+}
+
+func encodeUnrolled82Flickr(src []byte) string {
+	const chars = "123456789abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ"
+	var buf [115]byte
+	i := encodeDigits82(src, &buf)
+	for j := i; j < len(buf); j++ {
+		buf[j] = chars[buf[j]]
+	}
+	return string(buf[i:])
+}
+
+const (
+	// maxUnrolledDecode is the longest string decodeUnrolled accepts.
+	maxUnrolledDecode = 113
+	// maxUnrolledLimbs is the number of limbs of the largest decoder.
+	maxUnrolledLimbs = 21
+)
+
+// unrolledDecodable reports whether decodeUnrolled handles strings of
+// length n in the alphabet.
+func unrolledDecodable(n int, alphabet *Alphabet) bool {
+	return n > 0 && n <= maxUnrolledDecode && (alphabet == BTCAlphabet || alphabet == FlickrAlphabet)
+}
+
+// appendDecodeUnrolled appends the decoding of str, which must satisfy
+// unrolledDecodable, to dst. On error dst is returned unchanged.
+func appendDecodeUnrolled(dst []byte, str string, alphabet *Alphabet) ([]byte, error) {
+	var buf [maxUnrolledDecode]byte
+	digits := buf[:len(str)]
+	if n := translate(digits, str, alphabet); n < len(str) {
+		return dst, invalidDigitError(str, n)
+	}
+	zcount := 0
+	for zcount < len(digits) && digits[zcount] == 0 {
+		zcount++
+	}
+
+	var z [maxUnrolledLimbs]uint32
+	switch {
+	case len(str) <= 35:
+		decodeLimbs7(digits, &z)
+	case len(str) <= 47:
+		decodeLimbs9(digits, &z)
+	case len(str) <= 88:
+		decodeLimbs17(digits, &z)
+	default:
+		decodeLimbs21(digits, &z)
+	}
+	return appendUnrolledBytes(dst, z[:], zcount), nil
+}
+
+// decodeLimbs7 decodes the digit values into the last 7 limbs of z.
+func decodeLimbs7(digits []byte, z *[maxUnrolledLimbs]uint32) {
+	var x0, x1, x2, x3, x4, x5, x6 uint32
+	for i := 0; i < len(digits); i += 5 {
+		end := i + 5
+		if end > len(digits) {
+			end = len(digits)
+		}
+		m, c := uint64(1), uint64(0)
+		for _, d := range digits[i:end] {
+			m *= 58
+			c = c*58 + uint64(d)
+		}
+		t := uint64(x6)*m + c
+		x6 = uint32(t)
+		t = uint64(x5)*m + t>>32
+		x5 = uint32(t)
+		t = uint64(x4)*m + t>>32
+		x4 = uint32(t)
+		t = uint64(x3)*m + t>>32
+		x3 = uint32(t)
+		t = uint64(x2)*m + t>>32
+		x2 = uint32(t)
+		t = uint64(x1)*m + t>>32
+		x1 = uint32(t)
+		t = uint64(x0)*m + t>>32
+		x0 = uint32(t)
+	}
+	z[14], z[15], z[16], z[17], z[18], z[19], z[20] = x0, x1, x2, x3, x4, x5, x6
+}
+
+// decodeLimbs9 decodes the digit values into the last 9 limbs of z.
+func decodeLimbs9(digits []byte, z *[maxUnrolledLimbs]uint32) {
+	var x0, x1, x2, x3, x4, x5, x6, x7, x8 uint32
+	for i := 0; i < len(digits); i += 5 {
+		end := i + 5
+		if end > len(digits) {
+			end = len(digits)
+		}
+		m, c := uint64(1), uint64(0)
+		for _, d := range digits[i:end] {
+			m *= 58
+			c = c*58 + uint64(d)
+		}
+		t := uint64(x8)*m + c
+		x8 = uint32(t)
+		t = uint64(x7)*m + t>>32
+		x7 = uint32(t)
+		t = uint64(x6)*m + t>>32
+		x6 = uint32(t)
+		t = uint64(x5)*m + t>>32
+		x5 = uint32(t)
+		t = uint64(x4)*m + t>>32
+		x4 = uint32(t)
+		t = uint64(x3)*m + t>>32
+		x3 = uint32(t)
+		t = uint64(x2)*m + t>>32
+		x2 = uint32(t)
+		t = uint64(x1)*m + t>>32
+		x1 = uint32(t)
+		t = uint64(x0)*m + t>>32
+		x0 = uint32(t)
+	}
+	z[12], z[13], z[14], z[15], z[16], z[17], z[18], z[19], z[20] = x0, x1, x2, x3, x4, x5, x6, x7, x8
+}
+
+// decodeLimbs17 decodes the digit values into the last 17 limbs of z.
+func decodeLimbs17(digits []byte, z *[maxUnrolledLimbs]uint32) {
+	var x0, x1, x2, x3, x4, x5, x6, x7, x8, x9, x10, x11, x12, x13, x14, x15, x16 uint32
+	for i := 0; i < len(digits); i += 5 {
+		end := i + 5
+		if end > len(digits) {
+			end = len(digits)
+		}
+		m, c := uint64(1), uint64(0)
+		for _, d := range digits[i:end] {
+			m *= 58
+			c = c*58 + uint64(d)
+		}
+		t := uint64(x16)*m + c
+		x16 = uint32(t)
+		t = uint64(x15)*m + t>>32
+		x15 = uint32(t)
+		t = uint64(x14)*m + t>>32
+		x14 = uint32(t)
+		t = uint64(x13)*m + t>>32
+		x13 = uint32(t)
+		t = uint64(x12)*m + t>>32
+		x12 = uint32(t)
+		t = uint64(x11)*m + t>>32
+		x11 = uint32(t)
+		t = uint64(x10)*m + t>>32
+		x10 = uint32(t)
+		t = uint64(x9)*m + t>>32
+		x9 = uint32(t)
+		t = uint64(x8)*m + t>>32
+		x8 = uint32(t)
+		t = uint64(x7)*m + t>>32
+		x7 = uint32(t)
+		t = uint64(x6)*m + t>>32
+		x6 = uint32(t)
+		t = uint64(x5)*m + t>>32
+		x5 = uint32(t)
+		t = uint64(x4)*m + t>>32
+		x4 = uint32(t)
+		t = uint64(x3)*m + t>>32
+		x3 = uint32(t)
+		t = uint64(x2)*m + t>>32
+		x2 = uint32(t)
+		t = uint64(x1)*m + t>>32
+		x1 = uint32(t)
+		t = uint64(x0)*m + t>>32
+		x0 = uint32(t)
+	}
+	z[4], z[5], z[6], z[7], z[8], z[9], z[10], z[11], z[12], z[13], z[14], z[15], z[16], z[17], z[18], z[19], z[20] = x0, x1, x2, x3, x4, x5, x6, x7, x8, x9, x10, x11, x12, x13, x14, x15, x16
+}
+
+// decodeLimbs21 decodes the digit values into the last 21 limbs of z.
+func decodeLimbs21(digits []byte, z *[maxUnrolledLimbs]uint32) {
+	var x0, x1, x2, x3, x4, x5, x6, x7, x8, x9, x10, x11, x12, x13, x14, x15, x16, x17, x18, x19, x20 uint32
+	for i := 0; i < len(digits); i += 5 {
+		end := i + 5
+		if end > len(digits) {
+			end = len(digits)
+		}
+		m, c := uint64(1), uint64(0)
+		for _, d := range digits[i:end] {
+			m *= 58
+			c = c*58 + uint64(d)
+		}
+		t := uint64(x20)*m + c
+		x20 = uint32(t)
+		t = uint64(x19)*m + t>>32
+		x19 = uint32(t)
+		t = uint64(x18)*m + t>>32
+		x18 = uint32(t)
+		t = uint64(x17)*m + t>>32
+		x17 = uint32(t)
+		t = uint64(x16)*m + t>>32
+		x16 = uint32(t)
+		t = uint64(x15)*m + t>>32
+		x15 = uint32(t)
+		t = uint64(x14)*m + t>>32
+		x14 = uint32(t)
+		t = uint64(x13)*m + t>>32
+		x13 = uint32(t)
+		t = uint64(x12)*m + t>>32
+		x12 = uint32(t)
+		t = uint64(x11)*m + t>>32
+		x11 = uint32(t)
+		t = uint64(x10)*m + t>>32
+		x10 = uint32(t)
+		t = uint64(x9)*m + t>>32
+		x9 = uint32(t)
+		t = uint64(x8)*m + t>>32
+		x8 = uint32(t)
+		t = uint64(x7)*m + t>>32
+		x7 = uint32(t)
+		t = uint64(x6)*m + t>>32
+		x6 = uint32(t)
+		t = uint64(x5)*m + t>>32
+		x5 = uint32(t)
+		t = uint64(x4)*m + t>>32
+		x4 = uint32(t)
+		t = uint64(x3)*m + t>>32
+		x3 = uint32(t)
+		t = uint64(x2)*m + t>>32
+		x2 = uint32(t)
+		t = uint64(x1)*m + t>>32
+		x1 = uint32(t)
+		t = uint64(x0)*m + t>>32
+		x0 = uint32(t)
+	}
+	z[0], z[1], z[2], z[3], z[4], z[5], z[6], z[7], z[8], z[9], z[10], z[11], z[12], z[13], z[14], z[15], z[16], z[17], z[18], z[19], z[20] = x0, x1, x2, x3, x4, x5, x6, x7, x8, x9, x10, x11, x12, x13, x14, x15, x16, x17, x18, x19, x20
+}
@@ -0,0 +1,110 @@
+package base58
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+)
+
+var unrolledLengths = []int{25, 32, 33, 34, 64, 82}
+
+func TestUnrolledEncodeEqGeneric(t *testing.T) {
+	for _, alph := range []*Alphabet{BTCAlphabet, FlickrAlphabet} {
+		for _, n := range unrolledLengths {
+			for zeros := 0; zeros <= n; zeros++ {
+				b := make([]byte, n)
+				rand.Read(b[zeros:])
+				// Also cover the largest values.
+				if zeros%7 == 6 {
+					for i := zeros; i < n; i++ {
+						b[i] = 0xff
+					}
+				}
+				for i := 0; i < zeros; i++ {
+					b[i] = 0
+				}
+
+				got, ok := encodeUnrolled(b, alph)
+				if !ok {
+					t.Fatalf("no unrolled encoder for %d bytes", n)
+				}
+				if want := fastEncode(b, alph); got != want {
+					t.Fatalf("len %d: unrolled encoding %s, generic %s", n, got, want)
+				}
+				if app, ok := appendEncodeUnrolled([]byte("x"), b, alph); !ok || string(app) != "x"+got {
+					t.Fatalf("len %d: appended unrolled encoding %s, want x%s", n, app, got)
+				}
+			}
+		}
+	}
+	if _, ok := encodeUnrolled(make([]byte, 25), RippleAlphabet); ok {
+		t.Errorf("unrolled encoder used for an alphabet that was not generated")
+	}
+	if _, ok := appendEncodeUnrolled(nil, make([]byte, 25), RippleAlphabet); ok {
+		t.Errorf("unrolled encoder used for an alphabet that was not generated")
+	}
+}
+
+func TestUnrolledDecodeEqGeneric(t *testing.T) {
+	for _, alph := range []*Alphabet{BTCAlphabet, FlickrAlphabet} {
+		for n := 1; n <= maxUnrolledDecode; n++ {
+			for k := 0; k < 20; k++ {
+				s := make([]byte, n)
+				for i := range s {
+					s[i] = alph.encode[rand.Intn(58)]
+				}
+				// Leading zeros, and the largest value of this length.
+				switch k {
+				case 0:
+					for i := range s {
+						s[i] = alph.encode[57]
+					}
+				case 1, 2:
+					for i := 0; i < n/(k+1); i++ {
+						s[i] = alph.encode[0]
+					}
+				}
+				if !unrolledDecodable(n, alph) {
+					t.Fatalf("no unrolled decoder for %d characters", n)
+				}
+
+				got, gerr := decodeUnrolled(string(s), alph)
+				want, werr := fastDecode(string(s), alph)
+				if gerr != nil || werr != nil {
+					t.Fatalf("decode errors %v, %v", gerr, werr)
+				}
+				if !bytes.Equal(got, want) {
+					t.Fatalf("%s: unrolled decoding %x, generic %x", s, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestUnrolledDecodeErrors(t *testing.T) {
+	for _, s := range []string{"0", "1QCaxc8hutpdZ62iKZsn1TCG3nh7uPZojI", "1QCaxc8hutpdZ62iKZsn1\xffCG3nh7uPZojq"} {
+		_, gerr := decodeUnrolled(s, BTCAlphabet)
+		_, werr := fastDecode(s, BTCAlphabet)
+		if gerr == nil || werr == nil || gerr.Error() != werr.Error() {
+			t.Errorf("%q: errors differ: %v, %v", s, gerr, werr)
+		}
+	}
+}
+
+func BenchmarkUnrolledEncode25(b *testing.B) {
+	data := make([]byte, 25)
+	rand.Read(data)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		FastBase58Encoding(data)
+	}
+}
+
+func BenchmarkGenericEncode25(b *testing.B) {
+	data := make([]byte, 25)
+	rand.Read(data)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		fastEncode(data, BTCAlphabet)
+	}
+}
@@ -0,0 +1,209 @@
+//go:build go1.13
+// +build go1.13
+
+package base58
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"math"
+	"math/big"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// ErrVanityPattern is returned for a vanity pattern that is empty or uses
+// characters that cannot appear in a base58 string.
+var ErrVanityPattern = errors.New("invalid vanity pattern")
+
+// vanityKeySize is the size of the public keys VanityKey generates.
+const vanityKeySize = ed25519.PublicKeySize
+
+// VanityPattern describes the wanted start and end of a base58 encoded
+// public key, as used for Solana addresses.
+type VanityPattern struct {
+	Prefix     string
+	Suffix     string
+	IgnoreCase bool
+}
+
+// Validate checks that the pattern is not empty and only uses characters of
+// BTCAlphabet, or, if IgnoreCase is set, characters with a case variant in
+// it.
+func (p *VanityPattern) Validate() error {
+	if p.Prefix == "" && p.Suffix == "" {
+		return ErrVanityPattern
+	}
+	for _, c := range []byte(p.Prefix + p.Suffix) {
+		if p.matches(c) == 0 {
+			return ErrVanityPattern
+		}
+	}
+	return nil
+}
+
+// ExpectedAttempts estimates the number of keys to generate before one
+// matches. The prefix is weighed exactly for 32-byte keys: these encode to 43
+// or 44 characters, and 44-character keys only start with '1' to 'J', so 'a'
+// to 'z' are about 60 times rarer as a first character than '2' to 'H'. The
+// suffix digits are treated as uniformly distributed. It returns +Inf if no
+// key can match.
+func (p *VanityPattern) ExpectedAttempts() float64 {
+	count := new(big.Int)
+	for _, digits := range p.prefixVariants() {
+		count.Add(count, vanityPrefixCount(digits, vanityKeySize))
+	}
+	keys := new(big.Int).Lsh(big.NewInt(1), 8*vanityKeySize)
+	prob, _ := new(big.Rat).SetFrac(count, keys).Float64()
+	for _, c := range []byte(p.Suffix) {
+		prob *= float64(p.matches(c)) / 58
+	}
+	if prob == 0 {
+		return math.Inf(1)
+	}
+	return 1 / prob
+}
+
+// prefixVariants returns the digit values of every string the prefix
+// matches.
+func (p *VanityPattern) prefixVariants() [][]byte {
+	variants := [][]byte{nil}
+	for _, c := range []byte(p.Prefix) {
+		var next [][]byte
+		for _, v := range p.variants(c) {
+			for _, prefix := range variants {
+				next = append(next, append(append([]byte(nil), prefix...), byte(BTCAlphabet.decode[v])))
+			}
+		}
+		variants = next
+	}
+	return variants
+}
+
+// vanityPrefixCount returns the number of n-byte values whose encoding
+// starts with the digits.
+func vanityPrefixCount(digits []byte, n int) *big.Int {
+	if len(digits) == 0 {
+		return new(big.Int).Lsh(big.NewInt(1), uint(8*n))
+	}
+	if n == 0 {
+		return new(big.Int)
+	}
+	if digits[0] == 0 {
+		// Only a leading zero byte encodes as a leading zero digit.
+		return vanityPrefixCount(digits[1:], n-1)
+	}
+
+	// Values with a non-zero first byte lie in [lo, hi). Those encoding to
+	// l digits that start with the prefix lie in [v*58^(l-k), (v+1)*58^(l-k)),
+	// where v is the value of the k prefix digits.
+	lo := new(big.Int).Lsh(big.NewInt(1), uint(8*(n-1)))
+	hi := new(big.Int).Lsh(big.NewInt(1), uint(8*n))
+	v := new(big.Int)
+	for _, d := range digits {
+		v.Mul(v, bn58).Add(v, big.NewInt(int64(d)))
+	}
+	next := new(big.Int).Add(v, big.NewInt(1))
+
+	count := new(big.Int)
+	scale := big.NewInt(1)
+	for {
+		a := new(big.Int).Mul(v, scale)
+		if a.Cmp(hi) >= 0 {
+			return count
+		}
+		b := new(big.Int).Mul(next, scale)
+		if a.Cmp(lo) < 0 {
+			a = lo
+		}
+		if b.Cmp(hi) > 0 {
+			b = hi
+		}
+		if b.Cmp(a) > 0 {
+			count.Add(count, b.Sub(b, a))
+		}
+		scale.Mul(scale, bn58)
+	}
+}
+
+// Match reports whether s starts with the prefix and ends with the suffix.
+func (p *VanityPattern) Match(s string) bool {
+	if len(s) < len(p.Prefix)+len(p.Suffix) {
+		return false
+	}
+	head, tail := s[:len(p.Prefix)], s[len(s)-len(p.Suffix):]
+	if p.IgnoreCase {
+		return strings.EqualFold(head, p.Prefix) && strings.EqualFold(tail, p.Suffix)
+	}
+	return head == p.Prefix && tail == p.Suffix
+}
+
+// matches returns the number of digits c matches.
+func (p *VanityPattern) matches(c byte) int {
+	return len(p.variants(c))
+}
+
+// variants returns the characters of BTCAlphabet that c matches.
+func (p *VanityPattern) variants(c byte) []byte {
+	if c >= 128 {
+		return nil
+	}
+	candidates := []byte{c}
+	if p.IgnoreCase {
+		switch {
+		case 'a' <= c && c <= 'z':
+			candidates = append(candidates, c-'a'+'A')
+		case 'A' <= c && c <= 'Z':
+			candidates = append(candidates, c-'A'+'a')
+		}
+	}
+	var vs []byte
+	for _, v := range candidates {
+		if BTCAlphabet.decode[v] != -1 {
+			vs = append(vs, v)
+		}
+	}
+	return vs
+}
+
+// VanityKey generates ed25519 keys on all CPUs until the base58 encoding of a
+// public key matches p, and returns its private key. It returns ctx.Err() if
+// ctx is done first.
+func VanityKey(ctx context.Context, p *VanityPattern) (ed25519.PrivateKey, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		once  sync.Once
+		found ed25519.PrivateKey
+		fail  error
+		wg    sync.WaitGroup
+	)
+	for i := 0; i < runtime.NumCPU(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				pub, priv, err := ed25519.GenerateKey(rand.Reader)
+				if err == nil && !p.Match(Encode(pub)) {
+					continue
+				}
+				once.Do(func() { found, fail = priv, err })
+				cancel()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if found == nil && fail == nil {
+		return nil, ctx.Err()
+	}
+	return found, fail
+}
@@ -0,0 +1,124 @@
+//go:build go1.13
+// +build go1.13
+
+package base58
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestVanityPatternValidate(t *testing.T) {
+	tests := []struct {
+		p  VanityPattern
+		ok bool
+	}{
+		{VanityPattern{Prefix: "Sun"}, true},
+		{VanityPattern{Prefix: "Sol"}, false},
+		{VanityPattern{Prefix: "Sol", IgnoreCase: true}, true},
+		{VanityPattern{Suffix: "pay"}, true},
+		{VanityPattern{}, false},
+		{VanityPattern{Prefix: "0x"}, false},
+		{VanityPattern{Prefix: "Io"}, false},
+		{VanityPattern{Prefix: "Io", IgnoreCase: true}, true},
+		{VanityPattern{Suffix: "l", IgnoreCase: true}, true},
+		{VanityPattern{Suffix: "é", IgnoreCase: true}, false},
+	}
+	for _, tt := range tests {
+		if err := tt.p.Validate(); (err == nil) != tt.ok {
+			t.Errorf("%+v: got %v", tt.p, err)
+		}
+	}
+}
+
+func TestVanityPatternExpectedAttempts(t *testing.T) {
+	tests := []struct {
+		p    VanityPattern
+		want float64
+	}{
+		{VanityPattern{Prefix: "1"}, 256},
+		{VanityPattern{Prefix: "11"}, 256 * 256},
+		{VanityPattern{Prefix: "2"}, 17.23},
+		{VanityPattern{Prefix: "J"}, 69.80},
+		{VanityPattern{Prefix: "K"}, 999.3},
+		{VanityPattern{Prefix: "a"}, 999.3},
+		{VanityPattern{Prefix: "a", IgnoreCase: true}, 16.66},
+		{VanityPattern{Prefix: "2", Suffix: "b"}, 17.23 * 58},
+		{VanityPattern{Suffix: "b", IgnoreCase: true}, 29},
+		{VanityPattern{Prefix: "zz"}, 57960},
+		{VanityPattern{Prefix: strings.Repeat("1", 33)}, math.Inf(1)},
+	}
+	for _, tt := range tests {
+		got := tt.p.ExpectedAttempts()
+		if math.IsInf(tt.want, 1) != math.IsInf(got, 1) || math.Abs(got-tt.want) > tt.want*0.001 {
+			t.Errorf("%+v: got %v, want %v", tt.p, got, tt.want)
+		}
+	}
+
+	// Every key starts with one of the digits.
+	sum := 0.0
+	for _, c := range []byte(btcDigits) {
+		sum += 1 / (&VanityPattern{Prefix: string(c)}).ExpectedAttempts()
+	}
+	if math.Abs(sum-1) > 1e-9 {
+		t.Errorf("first digit probabilities sum to %v", sum)
+	}
+}
+
+func TestVanityPatternExpectedAttemptsSampled(t *testing.T) {
+	const samples = 100000
+	patterns := []*VanityPattern{{Prefix: "2"}, {Prefix: "H"}, {Prefix: "a", IgnoreCase: true}}
+	hits := make([]int, len(patterns))
+	key := make([]byte, vanityKeySize)
+	for i := 0; i < samples; i++ {
+		rand.Read(key)
+		s := Encode(key)
+		for j, p := range patterns {
+			if p.Match(s) {
+				hits[j]++
+			}
+		}
+	}
+	for j, p := range patterns {
+		want := samples / p.ExpectedAttempts()
+		if math.Abs(float64(hits[j])-want) > 5*math.Sqrt(want) {
+			t.Errorf("%+v: %d of %d keys matched, expected about %.0f", *p, hits[j], samples, want)
+		}
+	}
+}
+
+func TestVanityPatternMatch(t *testing.T) {
+	p := VanityPattern{Prefix: "So", Suffix: "ab"}
+	if !p.Match("Sox1ab") || p.Match("sox1ab") || p.Match("Sob") {
+		t.Error("case-sensitive match failed")
+	}
+	p.IgnoreCase = true
+	if !p.Match("sOx1AB") {
+		t.Error("case-insensitive match failed")
+	}
+}
+
+func TestVanityKey(t *testing.T) {
+	p := &VanityPattern{Prefix: "a", Suffix: "Z", IgnoreCase: true}
+	priv, err := VanityKey(context.Background(), p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := Encode(priv.Public().(ed25519.PublicKey))
+	if !strings.EqualFold(addr[:1], "a") || !strings.EqualFold(addr[len(addr)-1:], "z") {
+		t.Errorf("got %s", addr)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := VanityKey(ctx, &VanityPattern{Prefix: "zzzzzzzz"}); err != context.Canceled {
+		t.Errorf("got %v, want context.Canceled", err)
+	}
+	if _, err := VanityKey(context.Background(), &VanityPattern{Prefix: "0"}); err != ErrVanityPattern {
+		t.Errorf("got %v, want ErrVanityPattern", err)
+	}
+}
@@ -0,0 +1,48 @@
+package base58
+
+import "errors"
+
+// ErrWIF is returned for strings that are not mainnet Wallet Import Format
+// private keys, and when encoding keys that are not 32 bytes long.
+var ErrWIF = errors.New("malformed wif private key")
+
+const (
+	wifVersion    = 0x80
+	wifCompressed = 0x01
+	privKeySize   = 32
+)
+
+// EncodeWIF encodes a 32 byte private key in Wallet Import Format. The
+// compressed flag records that the key's public key is used in compressed
+// form.
+func EncodeWIF(key []byte, compressed bool) (string, error) {
+	if len(key) != privKeySize {
+		return "", ErrWIF
+	}
+	payload := make([]byte, 0, 1+privKeySize+1)
+	payload = append(payload, wifVersion)
+	payload = append(payload, key...)
+	if compressed {
+		payload = append(payload, wifCompressed)
+	}
+	return CheckEncode(payload), nil
+}
+
+// DecodeWIF decodes a Wallet Import Format private key.
+func DecodeWIF(s string) (key []byte, compressed bool, err error) {
+	payload, err := CheckDecode(s)
+	if err != nil {
+		return nil, false, err
+	}
+	switch {
+	case len(payload) == 1+privKeySize:
+	case len(payload) == 2+privKeySize && payload[1+privKeySize] == wifCompressed:
+		compressed = true
+	default:
+		return nil, false, ErrWIF
+	}
+	if payload[0] != wifVersion {
+		return nil, false, ErrWIF
+	}
+	return payload[1 : 1+privKeySize], compressed, nil
+}